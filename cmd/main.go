@@ -20,6 +20,7 @@ import (
 	"github.com/cfioretti/ingredients-balancer/pkg/application"
 	grpcServer "github.com/cfioretti/ingredients-balancer/pkg/infrastructure/grpc"
 	pb "github.com/cfioretti/ingredients-balancer/pkg/infrastructure/grpc/proto/generated"
+	"github.com/cfioretti/ingredients-balancer/pkg/infrastructure/rules"
 )
 
 const (
@@ -55,7 +56,15 @@ func main() {
 	httpPort := getHTTPPort()
 	logger.WithField("grpc_port", grpcPort).WithField("http_port", httpPort).Info("Server configuration loaded")
 
-	balancerService := application.NewIngredientsBalancerService()
+	balancerService := application.NewIngredientsBalancerService(prometheusMetrics)
+	if rulesFile := os.Getenv("SUBSTITUTION_RULES_FILE"); rulesFile != "" {
+		substitutionCatalog, err := rules.LoadSubstitutionCatalog(rulesFile)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to load substitution rules")
+		}
+		balancerService = balancerService.WithSubstitutionRules(substitutionCatalog)
+		logger.WithField("rules_file", rulesFile).Info("Substitution rules loaded")
+	}
 	server := grpcServer.NewServer(balancerService)
 
 	metricsMiddleware := middleware.NewMetricsMiddleware(prometheusMetrics, prometheusMetrics)
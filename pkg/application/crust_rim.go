@@ -0,0 +1,95 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func panRimWidth(pans domain.Pans, pan domain.Pan) float64 {
+	if pan.RimWidth > 0 {
+		return pan.RimWidth
+	}
+	if rimWidth, ok := defaultRimWidth(pans.DefaultRimWidths, pan.Style); ok {
+		return rimWidth
+	}
+	rimWidth, _ := defaultRimWidth(pans.DefaultRimWidths, pan.Shape)
+	return rimWidth
+}
+
+func defaultRimWidth(rimWidths map[string]float64, key string) (float64, bool) {
+	if key == "" {
+		return 0, false
+	}
+	for name, rimWidth := range rimWidths {
+		if canonicalName(name) == canonicalName(key) {
+			return rimWidth, true
+		}
+	}
+	return 0, false
+}
+
+func hasRims(pans domain.Pans) bool {
+	for _, pan := range pans.Pans {
+		if panRimWidth(pans, pan) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func innerPanArea(pan domain.Pan, rimWidth float64) (float64, error) {
+	if rimWidth <= 0 {
+		return pan.Area, nil
+	}
+	if pan.Area <= 0 {
+		return 0, errors.New("invalid pan area for " + pan.Name)
+	}
+
+	var ratio float64
+	switch pan.Shape {
+	case "round", "circular":
+		radius := math.Sqrt(pan.Area / math.Pi)
+		if pan.Measures.Diameter != nil {
+			radius = *pan.Measures.Diameter / 2
+		}
+		ratio = math.Pow(math.Max(radius-rimWidth, 0)/radius, 2)
+	case "square":
+		edge := math.Sqrt(pan.Area)
+		if pan.Measures.Edge != nil {
+			edge = *pan.Measures.Edge
+		}
+		ratio = math.Pow(math.Max(edge-2*rimWidth, 0)/edge, 2)
+	case "rectangular":
+		if pan.Measures.Width == nil || pan.Measures.Length == nil {
+			return 0, errors.New("rim width requires width and length for " + pan.Name)
+		}
+		width, length := *pan.Measures.Width, *pan.Measures.Length
+		ratio = math.Max(width-2*rimWidth, 0) * math.Max(length-2*rimWidth, 0) / (width * length)
+	case panShapeOval, panShapeEllipse:
+		if pan.Measures.MajorAxis == nil || pan.Measures.MinorAxis == nil {
+			return 0, errors.New("rim width requires both axes for " + pan.Name)
+		}
+		majorAxis, minorAxis := *pan.Measures.MajorAxis, *pan.Measures.MinorAxis
+		ratio = math.Max(majorAxis-2*rimWidth, 0) * math.Max(minorAxis-2*rimWidth, 0) / (majorAxis * minorAxis)
+	default:
+		return 0, errors.New("rim width not supported for shape " + pan.Shape)
+	}
+
+	if ratio <= 0 {
+		return 0, errors.New("rim width too large for " + pan.Name)
+	}
+	return pan.Area * ratio, nil
+}
+
+func toppingArea(pans domain.Pans, portions []toppingPortion) float64 {
+	if !hasRims(pans) {
+		return pans.TotalArea
+	}
+	area := 0.0
+	for _, portion := range portions {
+		area += portion.area
+	}
+	return area
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestInnerPanArea(t *testing.T) {
+	diameter, edge, width, length := 30.0, 10.0, 40.0, 60.0
+
+	tests := []struct {
+		name     string
+		pan      domain.Pan
+		rimWidth float64
+		want     float64
+		wantErr  string
+	}{
+		{
+			name: "no rim",
+			pan:  domain.Pan{Shape: "round", Area: 700},
+			want: 700,
+		},
+		{
+			name:     "round pan with diameter",
+			pan:      domain.Pan{Shape: "round", Area: math.Pi * 225, Measures: domain.Measures{Diameter: &diameter}},
+			rimWidth: 2,
+			want:     math.Pi * 169,
+		},
+		{
+			name:     "circular pan derived from area",
+			pan:      domain.Pan{Shape: "circular", Area: math.Pi * 100},
+			rimWidth: 2,
+			want:     math.Pi * 64,
+		},
+		{
+			name:     "square pan derived from area",
+			pan:      domain.Pan{Shape: "square", Area: 900},
+			rimWidth: 2,
+			want:     676,
+		},
+		{
+			name:     "rectangular pan",
+			pan:      domain.Pan{Shape: "rectangular", Area: 2400, Measures: domain.Measures{Width: &width, Length: &length}},
+			rimWidth: 1,
+			want:     2204,
+		},
+		{
+			name:     "rectangular pan without measures",
+			pan:      domain.Pan{Name: "teglia", Shape: "rectangular", Area: 2400},
+			rimWidth: 1,
+			wantErr:  "rim width requires width and length for teglia",
+		},
+		{
+			name:     "rim wider than the pan",
+			pan:      domain.Pan{Name: "quadrata", Shape: "square", Area: 100, Measures: domain.Measures{Edge: &edge}},
+			rimWidth: 5,
+			wantErr:  "rim width too large for quadrata",
+		},
+		{
+			name:     "unsupported shape",
+			pan:      domain.Pan{Shape: "hexagonal", Area: 100},
+			rimWidth: 1,
+			wantErr:  "rim width not supported for shape hexagonal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := innerPanArea(tt.pan, tt.rimWidth)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.want, result, 1e-9)
+		})
+	}
+}
+
+func TestBalance_WithCrustRim(t *testing.T) {
+	edge, side := 30.0, 20.0
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	pans := domain.Pans{
+		TotalArea: 1300,
+		Pans: []domain.Pan{
+			{Name: "quadrata", Shape: "square", Area: 900, Measures: domain.Measures{Edge: &edge}},
+			{Name: "teglia", Shape: "rectangular", Area: 400, Measures: domain.Measures{Width: &side, Length: &side}},
+		},
+		DefaultRimWidths: map[string]float64{"Square": 2},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 650.0, sumIngredients(result.Dough.Ingredients))
+	assert.Equal(t, 1076.0, result.ToppingArea)
+	assert.Equal(t, 322.8, result.Topping.Ingredients[0].Amount)
+	assert.Equal(t, 202.8, result.SplitIngredients.SplitTopping[0].Ingredients[0].Amount)
+	assert.Equal(t, 120.0, result.SplitIngredients.SplitTopping[1].Ingredients[0].Amount)
+
+	pans.Pans[1].RimWidth = 1
+	result, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, result.ToppingArea)
+	assert.Equal(t, 300.0, result.Topping.Ingredients[0].Amount)
+}
+
+func TestPanRimWidth(t *testing.T) {
+	pans := domain.Pans{DefaultRimWidths: map[string]float64{"Napoletana": 2, "round": 1}}
+
+	tests := []struct {
+		name string
+		pan  domain.Pan
+		want float64
+	}{
+		{name: "pan rim width", pan: domain.Pan{Shape: "round", Style: "napoletana", RimWidth: 3}, want: 3},
+		{name: "style default", pan: domain.Pan{Shape: "round", Style: " NAPOLETANA "}, want: 2},
+		{name: "shape default", pan: domain.Pan{Shape: "Round", Style: "romana"}, want: 1},
+		{name: "no default", pan: domain.Pan{Shape: "square", Style: "romana"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, panRimWidth(pans, tt.pan))
+		})
+	}
+}
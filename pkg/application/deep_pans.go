@@ -0,0 +1,103 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const defaultWallCoverage = 1
+
+func panPerimeter(pan domain.Pan) (float64, error) {
+	switch pan.Shape {
+	case "round", "circular":
+		if pan.Measures.Diameter == nil {
+			return 2 * math.Sqrt(math.Pi*pan.Area), nil
+		}
+	case "square":
+		if pan.Measures.Edge == nil {
+			return 4 * math.Sqrt(pan.Area), nil
+		}
+	case "rectangular":
+		if pan.Measures.Width == nil || pan.Measures.Length == nil {
+			return 0, errors.New("deep pan requires width and length for " + pan.Name)
+		}
+	}
+
+	geometry, err := calculatePanGeometry(pan)
+	if err != nil {
+		return 0, err
+	}
+	return geometry.perimeter, nil
+}
+
+func panSurfaceArea(pan domain.Pan) (float64, error) {
+	if !pan.Deep {
+		return pan.Area, nil
+	}
+
+	if pan.Measures.Height == nil || *pan.Measures.Height <= 0 {
+		return 0, errors.New("deep pan requires height for " + pan.Name)
+	}
+	wallCoverage := float64(defaultWallCoverage)
+	if pan.Measures.WallCoverage != nil {
+		wallCoverage = *pan.Measures.WallCoverage
+	}
+	if wallCoverage < 0 || wallCoverage > 1 {
+		return 0, errors.New("invalid wall coverage for " + pan.Name)
+	}
+
+	perimeter, err := panPerimeter(pan)
+	if err != nil {
+		return 0, err
+	}
+	return pan.Area + perimeter*measure(pan.Measures.Height)*wallCoverage, nil
+}
+
+func panDoughArea(pan domain.Pan) (float64, error) {
+	if pan.TopCrustShare < 0 {
+		return 0, errors.New("invalid top crust share for " + pan.Name)
+	}
+	multiplier, err := thicknessMultiplier(pan)
+	if err != nil {
+		return 0, err
+	}
+	area, err := panSurfaceArea(pan)
+	if err != nil {
+		return 0, err
+	}
+	return (area + pan.Area*pan.TopCrustShare) * multiplier, nil
+}
+
+func doughPans(pans domain.Pans) (domain.Pans, error) {
+	weighted := domain.Pans{
+		Pans:      make([]domain.Pan, len(pans.Pans)),
+		TotalArea: pans.TotalArea,
+	}
+	for i, pan := range pans.Pans {
+		area, err := panDoughArea(pan)
+		if err != nil {
+			return domain.Pans{}, err
+		}
+		weighted.Pans[i] = pan
+		weighted.Pans[i].Area = area
+		weighted.TotalArea += area - pan.Area
+	}
+	return weighted, nil
+}
+
+func calculateSplitTopCrusts(totalDough domain.Dough, pans domain.Pans, weighted domain.Pans) []domain.Dough {
+	var topCrusts []domain.Dough
+	for _, pan := range pans.Pans {
+		if pan.TopCrustShare <= 0 {
+			continue
+		}
+		multiplier, _ := thicknessMultiplier(pan)
+		topCrusts = append(topCrusts, domain.Dough{
+			Name:        pan.Name,
+			Ingredients: balanceIngredients(totalDough.Ingredients, pan.Area*pan.TopCrustShare*multiplier/weighted.TotalArea),
+		})
+	}
+	return topCrusts
+}
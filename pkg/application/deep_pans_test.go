@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestPanDoughArea(t *testing.T) {
+	diameter, width, length, height := 30.0, 25.0, 35.0, 5.0
+	halfCoverage, overCoverage := 0.5, 1.5
+
+	tests := []struct {
+		name    string
+		pan     domain.Pan
+		want    float64
+		wantErr string
+	}{
+		{
+			name: "flat pan",
+			pan:  domain.Pan{Shape: "round", Area: 600},
+			want: 600,
+		},
+		{
+			name: "stuffed pan with top crust",
+			pan:  domain.Pan{Shape: "round", Area: 600, TopCrustShare: 0.5},
+			want: 900,
+		},
+		{
+			name: "deep rectangular pan",
+			pan: domain.Pan{
+				Shape:    "rectangular",
+				Area:     875,
+				Deep:     true,
+				Measures: domain.Measures{Width: &width, Length: &length, Height: &height},
+			},
+			want: 1475,
+		},
+		{
+			name: "deep round pan with partial wall coverage",
+			pan: domain.Pan{
+				Shape:    "round",
+				Area:     math.Pi * 225,
+				Deep:     true,
+				Measures: domain.Measures{Diameter: &diameter, Height: &height, WallCoverage: &halfCoverage},
+			},
+			want: math.Pi * 300,
+		},
+		{
+			name:    "deep pan without height",
+			pan:     domain.Pan{Name: "detroit", Shape: "square", Area: 900, Deep: true},
+			wantErr: "deep pan requires height for detroit",
+		},
+		{
+			name:    "deep rectangular pan without measures",
+			pan:     domain.Pan{Name: "detroit", Shape: "rectangular", Area: 875, Deep: true, Measures: domain.Measures{Height: &height}},
+			wantErr: "deep pan requires width and length for detroit",
+		},
+		{
+			name:    "wall coverage above one",
+			pan:     domain.Pan{Name: "chicago", Shape: "round", Area: 600, Deep: true, Measures: domain.Measures{Height: &height, WallCoverage: &overCoverage}},
+			wantErr: "invalid wall coverage for chicago",
+		},
+		{
+			name:    "negative top crust share",
+			pan:     domain.Pan{Name: "chicago", Shape: "round", Area: 600, TopCrustShare: -0.5},
+			wantErr: "invalid top crust share for chicago",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := panDoughArea(tt.pan)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.want, result, 1e-9)
+		})
+	}
+}
+
+func TestBalance_WithDeepPans(t *testing.T) {
+	width, length, height := 25.0, 35.0, 5.0
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("deep pan adds wall area to the dough", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 1400,
+			Pans: []domain.Pan{
+				{
+					Name:     "detroit",
+					Shape:    "rectangular",
+					Area:     875,
+					Deep:     true,
+					Measures: domain.Measures{Width: &width, Length: &length, Height: &height},
+				},
+				{Name: "tonda", Shape: "round", Area: 525},
+			},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.0, sumIngredients(result.Dough.Ingredients))
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 442.5}, {Name: "water", Amount: 295}}, result.SplitIngredients.SplitDough[0].Ingredients)
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 157.5}, {Name: "water", Amount: 105}}, result.SplitIngredients.SplitDough[1].Ingredients)
+		assert.Equal(t, 420.0, result.Topping.Ingredients[0].Amount)
+		assert.Nil(t, result.SplitIngredients.SplitTopCrust)
+	})
+
+	t.Run("stuffed pan declares a top crust", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 600,
+			Pans:      []domain.Pan{{Name: "chicago", Shape: "round", Area: 600, TopCrustShare: 0.5}},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 450.0, sumIngredients(result.SplitIngredients.SplitDough[0].Ingredients))
+		assert.Equal(t, []domain.Dough{
+			{Name: "chicago", Ingredients: []domain.Ingredient{{Name: "flour", Amount: 90}, {Name: "water", Amount: 60}}},
+		}, result.SplitIngredients.SplitTopCrust)
+		assert.Equal(t, 180.0, result.Topping.Ingredients[0].Amount)
+	})
+}
@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func (bs IngredientsBalancerService) BalanceDoughBalls(ctx context.Context, recipe domain.Recipe, groups []domain.DoughBallGroup) (*domain.RecipeAggregate, error) {
+	if len(groups) == 0 || getFirstIngredientAmount(recipe.Dough.Ingredients) <= 0 {
+		return nil, errors.New("invalid dough weight")
+	}
+
+	var err error
+	recipe.Dough, err = solveDoughTargets(recipe.Dough)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		totalBallWeight float64
+		totalPizzaArea  float64
+		splitDoughs     []domain.Dough
+		doughBalls      []domain.DoughBallPortion
+		portions        []toppingPortion
+	)
+	for _, group := range groups {
+		thicknessFactor := group.ThicknessFactor
+		if thicknessFactor <= 0 {
+			thicknessFactor = doughWeightPerArea
+		}
+
+		pizzaArea := math.Pi * math.Pow(group.Diameter/2, 2)
+		ballWeight := group.BallWeight
+		if ballWeight <= 0 {
+			ballWeight = round(pizzaArea * thicknessFactor)
+		}
+		if group.Count <= 0 || ballWeight <= 0 {
+			return nil, errors.New("invalid dough ball group")
+		}
+		if pizzaArea <= 0 {
+			pizzaArea = ballWeight / thicknessFactor
+		}
+
+		name := group.Name
+		if name == "" {
+			name = fmt.Sprintf("%d x %gg", group.Count, ballWeight)
+		}
+
+		groupWeight := float64(group.Count) * ballWeight
+		totalBallWeight += groupWeight
+		totalPizzaArea += float64(group.Count) * pizzaArea
+		portions = append(portions, toppingPortion{
+			name:   name,
+			area:   float64(group.Count) * pizzaArea,
+			count:  group.Count,
+			share:  1,
+			recipe: group.ToppingRecipe,
+		})
+
+		splitDoughs = append(splitDoughs, domain.Dough{
+			Name:        name,
+			Ingredients: balanceIngredients(recipe.Dough.Ingredients, groupWeight/totalPercentage),
+		})
+		doughBalls = append(doughBalls, domain.DoughBallPortion{
+			Name:        name,
+			Count:       group.Count,
+			BallWeight:  ballWeight,
+			Ingredients: balanceIngredients(recipe.Dough.Ingredients, ballWeight/totalPercentage),
+		})
+	}
+
+	surplusWeight := totalBallWeight * recipe.Dough.PercentVariation / 100
+	balancedDough := domain.Dough{
+		PercentVariation: recipe.Dough.PercentVariation,
+		Ingredients:      balanceIngredients(recipe.Dough.Ingredients, (totalBallWeight+surplusWeight)/totalPercentage),
+		ProcessLoss:      recipe.Dough.ProcessLoss,
+	}
+
+	balancedTopping, splitToppings, toppingGroups, err := balanceRecipeTopping(recipe, totalPizzaArea, portions)
+	if err != nil {
+		return nil, err
+	}
+	balancedLayers, splitLayers, err := balanceToppingLayers(recipe.ToppingLayers, totalPizzaArea, portions)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeAggregate := &domain.RecipeAggregate{
+		Recipe: recipe,
+		SplitIngredients: domain.SplitIngredients{
+			SplitDough:         splitDoughs,
+			SplitTopping:       splitToppings,
+			SplitToppingLayers: splitLayers,
+		},
+		DoughBalls: doughBalls,
+		SurplusDough: domain.Dough{
+			Name:        "surplus",
+			Ingredients: balanceIngredients(recipe.Dough.Ingredients, surplusWeight/totalPercentage),
+		},
+	}
+	recipeAggregate.Dough = balancedDough
+	recipeAggregate.Topping = balancedTopping
+	recipeAggregate.ToppingLayers = balancedLayers
+	recipeAggregate.ToppingGroups = toppingGroups
+	recipeAggregate.ToppingArea = round(totalPizzaArea)
+
+	if err := applyDoughFormula(recipeAggregate, recipe.Dough); err != nil {
+		return nil, err
+	}
+
+	return recipeAggregate, nil
+}
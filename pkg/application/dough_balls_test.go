@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalanceDoughBalls(t *testing.T) {
+	recipe := domain.Recipe{
+		Name: "Napoletana",
+		Dough: domain.Dough{
+			PercentVariation: 2,
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 38},
+				{Name: "salt", Amount: 2},
+			},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("balls by weight and by diameter", func(t *testing.T) {
+		result, err := balancer.BalanceDoughBalls(context.Background(), recipe, []domain.DoughBallGroup{
+			{Count: 10, BallWeight: 250},
+			{Name: "pizze grandi", Count: 4, Diameter: 30, ThicknessFactor: 0.4},
+		})
+
+		assert.NoError(t, err)
+
+		assert.Len(t, result.DoughBalls, 2)
+		assert.Equal(t, "10 x 250g", result.DoughBalls[0].Name)
+		assert.Equal(t, 250.0, result.DoughBalls[0].BallWeight)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "flour", Amount: 150},
+			{Name: "water", Amount: 95},
+			{Name: "salt", Amount: 5},
+		}, result.DoughBalls[0].Ingredients)
+		assert.Equal(t, "pizze grandi", result.DoughBalls[1].Name)
+		assert.Equal(t, 282.7, result.DoughBalls[1].BallWeight)
+
+		assert.Len(t, result.SplitIngredients.SplitDough, 2)
+		assert.Equal(t, "10 x 250g", result.SplitIngredients.SplitDough[0].Name)
+		assert.Equal(t, 1500.0, result.SplitIngredients.SplitDough[0].Ingredients[0].Amount)
+
+		assert.Equal(t, 43.6, result.SurplusDough.Ingredients[0].Amount)
+		assert.Equal(t, 2222.0, result.Dough.Ingredients[0].Amount)
+		assert.InDelta(t, 3703.4, sumIngredients(result.Dough.Ingredients), 0.1)
+
+		assert.Equal(t, 2348.2, result.Topping.Ingredients[0].Amount)
+	})
+
+	t.Run("default thickness factor follows the pan model", func(t *testing.T) {
+		result, err := balancer.BalanceDoughBalls(context.Background(), recipe, []domain.DoughBallGroup{
+			{Count: 1, Diameter: 20},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 157.1, result.DoughBalls[0].BallWeight)
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		invalidGroups := [][]domain.DoughBallGroup{
+			nil,
+			{{Count: 0, BallWeight: 250}},
+			{{Count: 3}},
+		}
+
+		for _, groups := range invalidGroups {
+			result, err := balancer.BalanceDoughBalls(context.Background(), recipe, groups)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		}
+	})
+}
@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	ingredientsValidationType  = "ingredients"
+	doughTargetsValidationType = "dough_targets"
+	prefermentValidationType   = "preferment"
+)
+
+func (bs IngredientsBalancerService) ValidateRecipe(ctx context.Context, recipe domain.Recipe) domain.RecipeValidation {
+	var issues []string
+	if sumIngredients(recipe.Dough.Ingredients) <= 0 {
+		issues = append(issues, "dough has no ingredients")
+	}
+	for _, ingredient := range recipe.Dough.Ingredients {
+		if ingredient.Amount < 0 {
+			issues = append(issues, "negative amount for "+ingredient.Name)
+		}
+	}
+	issues = append(issues, doughTargetIssues(recipe.Dough)...)
+	if len(issues) == 0 {
+		issues = append(issues, solvedDoughIssues(recipe.Dough)...)
+	}
+
+	valid := len(issues) == 0
+	bs.metrics.IncrementRecipeValidations(recipeValidationType(recipe.Dough), valid)
+
+	return domain.RecipeValidation{
+		Valid:  valid,
+		Issues: issues,
+	}
+}
+
+func recipeValidationType(dough domain.Dough) string {
+	switch {
+	case hasDoughTargets(dough.Targets) || dough.Fermentation != nil:
+		return doughTargetsValidationType
+	case dough.Preferment != nil:
+		return prefermentValidationType
+	default:
+		return ingredientsValidationType
+	}
+}
+
+func hasDoughTargets(targets domain.DoughTargets) bool {
+	return targets.Hydration != 0 || targets.Salt != 0 || targets.Yeast != 0
+}
+
+func doughTargetIssues(dough domain.Dough) []string {
+	targets := dough.Targets
+	if !hasDoughTargets(targets) && dough.Fermentation == nil {
+		return nil
+	}
+
+	var issues []string
+	if targets.Hydration < 0 {
+		issues = append(issues, "hydration target cannot be negative")
+	}
+	if targets.Salt < 0 {
+		issues = append(issues, "salt target cannot be negative")
+	}
+	if targets.Yeast < 0 {
+		issues = append(issues, "yeast target cannot be negative")
+	}
+	if dough.Fermentation != nil {
+		issues = append(issues, fermentationIssues(*dough.Fermentation)...)
+		if targets.Yeast != 0 {
+			issues = append(issues, "yeast target conflicts with fermentation model")
+		}
+	}
+
+	totalFlour, starterWater := flourAndStarterWater(dough.Ingredients)
+	if totalFlour <= 0 {
+		issues = append(issues, "dough targets require flour in the dough")
+	} else if targets.Hydration > 0 && starterWater > totalFlour*targets.Hydration/100 {
+		issues = append(issues, "starter water exceeds target hydration")
+	}
+
+	return issues
+}
+
+func solvedDoughIssues(dough domain.Dough) []string {
+	solved, err := solveDoughTargets(dough)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var issues []string
+	if dough.Targets.Hydration > 0 {
+		_, effectiveHydration := calculateFormula(solved.Ingredients)
+		if warning := hydrationWarning(effectiveHydration, dough.Targets.Hydration); warning != "" {
+			issues = append(issues, warning)
+		}
+	}
+	if dough.Preferment != nil {
+		if _, err := splitPreferment(solved, *dough.Preferment); err != nil {
+			issues = append(issues, err.Error())
+		}
+	}
+	return issues
+}
+
+func solveDoughTargets(dough domain.Dough) (domain.Dough, error) {
+	if issues := doughTargetIssues(dough); len(issues) > 0 {
+		return dough, errors.New(issues[0])
+	}
+
+	targets := dough.Targets
+	if dough.Fermentation != nil {
+		targets.Yeast, _ = yeastPercentage(*dough.Fermentation)
+	}
+	if !hasDoughTargets(targets) {
+		return dough, nil
+	}
+
+	totalFlour, starterWater := flourAndStarterWater(dough.Ingredients)
+	ingredients := make([]domain.Ingredient, len(dough.Ingredients))
+	copy(ingredients, dough.Ingredients)
+	if targets.Hydration > 0 && sumIngredientsOfKind(dough.Ingredients, ingredientKindWater) <= 0 {
+		ingredients = setKindAmount(ingredients, ingredientKindWater, ingredientKindWater, totalFlour*targets.Hydration/100-starterWater)
+	}
+	if targets.Salt > 0 && sumIngredientsOfKind(dough.Ingredients, ingredientKindSalt) <= 0 {
+		ingredients = setKindAmount(ingredients, ingredientKindSalt, ingredientKindSalt, totalFlour*targets.Salt/100)
+	}
+	if targets.Yeast > 0 && (dough.Fermentation != nil || sumIngredientsOfKind(dough.Ingredients, ingredientKindYeast) <= 0) {
+		ingredients = setKindAmount(ingredients, ingredientKindYeast, yeastIngredientName(dough.Fermentation), totalFlour*targets.Yeast/100)
+		if dough.Fermentation != nil {
+			ingredients = matchYeastType(ingredients, yeastType(*dough.Fermentation))
+		}
+	}
+
+	scale := sumIngredients(dough.Ingredients) / sumIngredients(ingredients)
+	for i := range ingredients {
+		ingredients[i].Amount *= scale
+	}
+
+	dough.Ingredients = ingredients
+	return dough, nil
+}
+
+func flourAndStarterWater(ingredients []domain.Ingredient) (float64, float64) {
+	totalFlour := sumIngredientsOfKind(ingredients, ingredientKindFlour)
+	starterWater := 0.0
+	for _, ingredient := range ingredients {
+		if ingredientKind(ingredient) == ingredientKindStarter {
+			flour, water := starterComposition(ingredient)
+			totalFlour += flour
+			starterWater += water
+		}
+	}
+	return totalFlour, starterWater
+}
+
+func setKindAmount(ingredients []domain.Ingredient, kind string, name string, amount float64) []domain.Ingredient {
+	current := sumIngredientsOfKind(ingredients, kind)
+	found := false
+	for i, ingredient := range ingredients {
+		if ingredientKind(ingredient) != kind {
+			continue
+		}
+		switch {
+		case current > 0:
+			ingredients[i].Amount = ingredient.Amount / current * amount
+		case !found:
+			ingredients[i].Amount = amount
+		}
+		found = true
+	}
+	if !found {
+		ingredients = append(ingredients, domain.Ingredient{Name: name, Amount: amount, Type: kind})
+	}
+	return ingredients
+}
+
+func solvedTargets(ingredients []domain.Ingredient) *domain.SolvedTargets {
+	totalFlour, _ := flourAndStarterWater(ingredients)
+	return &domain.SolvedTargets{
+		TotalFlour: round(totalFlour),
+		Water:      round(sumIngredientsOfKind(ingredients, ingredientKindWater)),
+		Salt:       round(sumIngredientsOfKind(ingredients, ingredientKindSalt)),
+		Yeast:      round(sumIngredientsOfKind(ingredients, ingredientKindYeast)),
+	}
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestSolveDoughTargets(t *testing.T) {
+	t.Run("solves water, salt and yeast from the flour blend", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "tipo 0 flour", Amount: 60},
+				{Name: "whole wheat flour", Amount: 20},
+				{Name: "water", Amount: 0},
+				{Name: "salt", Amount: 0},
+			},
+			Targets: domain.DoughTargets{Hydration: 70, Salt: 2.8, Yeast: 0.2},
+		}
+
+		result, err := solveDoughTargets(dough)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Ingredients, 5)
+		assert.Equal(t, domain.Ingredient{Name: "yeast", Amount: result.Ingredients[4].Amount, Type: "yeast"}, result.Ingredients[4])
+
+		flour := result.Ingredients[0].Amount + result.Ingredients[1].Amount
+		assert.InDelta(t, 80, sumIngredients(result.Ingredients), 1e-9)
+		assert.InDelta(t, 3, result.Ingredients[0].Amount/result.Ingredients[1].Amount, 1e-9)
+		assert.InDelta(t, 0.7, result.Ingredients[2].Amount/flour, 1e-9)
+		assert.InDelta(t, 0.028, result.Ingredients[3].Amount/flour, 1e-9)
+		assert.InDelta(t, 0.002, result.Ingredients[4].Amount/flour, 1e-9)
+		assert.Equal(t, 0.0, dough.Ingredients[3].Amount)
+	})
+
+	t.Run("stated amounts are kept over the targets", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 80},
+				{Name: "water", Amount: 18.7},
+				{Name: "salt", Amount: 1},
+				{Name: "yeast", Amount: 0.3},
+			},
+			Targets: domain.DoughTargets{Hydration: 70, Salt: 2.5, Yeast: 0.5},
+		}
+
+		result, err := solveDoughTargets(dough)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dough.Ingredients, result.Ingredients)
+	})
+
+	t.Run("starter water counts towards hydration", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 90},
+				{Name: "levain", Amount: 20},
+			},
+			Targets: domain.DoughTargets{Hydration: 65},
+		}
+
+		result, err := solveDoughTargets(dough)
+
+		assert.NoError(t, err)
+		_, hydration := calculateFormula(result.Ingredients)
+		assert.Equal(t, 65.0, hydration)
+		assert.InDelta(t, 110, sumIngredients(result.Ingredients), 1e-9)
+	})
+
+	t.Run("no targets", func(t *testing.T) {
+		dough := domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}}}
+
+		result, err := solveDoughTargets(dough)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dough, result)
+	})
+
+	t.Run("inconsistent targets", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "water", Amount: 100}},
+			Targets:     domain.DoughTargets{Salt: 2.5},
+		}
+
+		_, err := solveDoughTargets(dough)
+
+		assert.EqualError(t, err, "dough targets require flour in the dough")
+	})
+}
+
+func TestBalance_WithDoughTargets(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 100},
+			},
+			Targets: domain.DoughTargets{Hydration: 70, Salt: 2.8, Yeast: 0.2},
+		},
+		Topping: domain.Topping{ReferenceArea: 1000},
+	}
+	pans := domain.Pans{
+		TotalArea: 2000,
+		Pans:      []domain.Pan{{Name: "teglia", Area: 2000}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 1000, sumIngredients(result.Dough.Ingredients), 0.2)
+	assert.Equal(t, &domain.SolvedTargets{TotalFlour: 578, Water: 404.6, Salt: 16.2, Yeast: 1.2}, result.SolvedTargets)
+	assert.Equal(t, 70.0, result.EffectiveHydration)
+	assert.Empty(t, result.Warnings)
+}
+
+func TestValidateRecipe(t *testing.T) {
+	tests := []struct {
+		name           string
+		dough          domain.Dough
+		validationType string
+		issues         []string
+	}{
+		{
+			name: "consistent targets",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}},
+				Targets:     domain.DoughTargets{Hydration: 70, Salt: 2.8},
+			},
+			validationType: "dough_targets",
+		},
+		{
+			name:           "empty dough",
+			dough:          domain.Dough{},
+			validationType: "ingredients",
+			issues:         []string{"dough has no ingredients"},
+		},
+		{
+			name: "negative targets",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}},
+				Targets:     domain.DoughTargets{Hydration: -1, Yeast: -0.2},
+			},
+			validationType: "dough_targets",
+			issues:         []string{"hydration target cannot be negative", "yeast target cannot be negative"},
+		},
+		{
+			name: "starter wetter than target",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{
+					{Name: "flour", Amount: 10},
+					{Name: "starter", Amount: 90},
+				},
+				Targets: domain.DoughTargets{Hydration: 60},
+			},
+			validationType: "dough_targets",
+			issues:         []string{"starter water exceeds target hydration"},
+		},
+		{
+			name: "stated water contradicts hydration target",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+				Targets:     domain.DoughTargets{Hydration: 60},
+			},
+			validationType: "dough_targets",
+			issues:         []string{"effective hydration 66.7% differs from target hydration 60%"},
+		},
+		{
+			name: "preferment cannot be built from solved dough",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 0}},
+				Preferment:  &domain.Preferment{Type: "poolish", Hydration: 100, FlourPercentage: 80},
+				Targets:     domain.DoughTargets{Hydration: 60},
+			},
+			validationType: "dough_targets",
+			issues:         []string{"preferment water exceeds dough water"},
+		},
+		{
+			name: "preferment without targets",
+			dough: domain.Dough{
+				Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+				Preferment:  &domain.Preferment{Type: "poolish", Hydration: 100, FlourPercentage: 30},
+			},
+			validationType: "preferment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balancerMetrics := &MockBalancerMetrics{}
+			balancerMetrics.On("IncrementRecipeValidations", tt.validationType, len(tt.issues) == 0).Return()
+			balancer := NewIngredientsBalancerService(balancerMetrics)
+
+			result := balancer.ValidateRecipe(context.Background(), domain.Recipe{Dough: tt.dough})
+
+			assert.Equal(t, len(tt.issues) == 0, result.Valid)
+			assert.Equal(t, tt.issues, result.Issues)
+			balancerMetrics.AssertExpectations(t)
+		})
+	}
+}
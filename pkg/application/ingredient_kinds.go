@@ -0,0 +1,53 @@
+package application
+
+import (
+	"strings"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	ingredientKindFlour   = "flour"
+	ingredientKindWater   = "water"
+	ingredientKindYeast   = "yeast"
+	ingredientKindSalt    = "salt"
+	ingredientKindStarter = "starter"
+	ingredientKindOther   = "other"
+)
+
+var ingredientKindKeywords = []struct {
+	kind     string
+	keywords []string
+}{
+	{kind: ingredientKindStarter, keywords: []string{"starter", "levain", "lievito madre", "pasta madre", "sourdough"}},
+	{kind: ingredientKindFlour, keywords: []string{"flour", "farina", "semola", "semolina"}},
+	{kind: ingredientKindWater, keywords: []string{"water", "acqua"}},
+	{kind: ingredientKindYeast, keywords: []string{"yeast", "lievito"}},
+	{kind: ingredientKindSalt, keywords: []string{"salt", "sale"}},
+}
+
+func ingredientKind(ingredient domain.Ingredient) string {
+	if ingredient.Type != "" {
+		return canonicalName(ingredient.Type)
+	}
+
+	name := canonicalName(ingredient.Name)
+	for _, entry := range ingredientKindKeywords {
+		for _, keyword := range entry.keywords {
+			if strings.Contains(name, keyword) {
+				return entry.kind
+			}
+		}
+	}
+	return ingredientKindOther
+}
+
+func sumIngredientsOfKind(ingredients []domain.Ingredient, kind string) float64 {
+	total := 0.0
+	for _, ingredient := range ingredients {
+		if ingredientKind(ingredient) == kind {
+			total += ingredient.Amount
+		}
+	}
+	return total
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestIngredientKind(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Farina 00", want: ingredientKindFlour},
+		{name: "Manitoba Flour", want: ingredientKindFlour},
+		{name: "Semola rimacinata", want: ingredientKindFlour},
+		{name: "Acqua", want: ingredientKindWater},
+		{name: "water", want: ingredientKindWater},
+		{name: "Lievito di birra", want: ingredientKindYeast},
+		{name: "instant yeast", want: ingredientKindYeast},
+		{name: "Sale", want: ingredientKindSalt},
+		{name: "Lievito madre", want: ingredientKindStarter},
+		{name: "rye levain", want: ingredientKindStarter},
+		{name: "evoOil", want: ingredientKindOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ingredientKind(domain.Ingredient{Name: tt.name}))
+		})
+	}
+}
+
+func TestIngredientKind_ExplicitType(t *testing.T) {
+	assert.Equal(t, ingredientKindStarter, ingredientKind(domain.Ingredient{Name: "Mother", Type: "Starter"}))
+	assert.Equal(t, ingredientKindFlour, ingredientKind(domain.Ingredient{Name: "Tipo 1", Type: "flour"}))
+}
+
+func TestSumIngredientsOfKind(t *testing.T) {
+	ingredients := []domain.Ingredient{
+		{Name: "flour type 0", Amount: 800},
+		{Name: "whole wheat flour", Amount: 200},
+		{Name: "water", Amount: 650},
+	}
+
+	assert.Equal(t, 1000.0, sumIngredientsOfKind(ingredients, ingredientKindFlour))
+	assert.Equal(t, 650.0, sumIngredientsOfKind(ingredients, ingredientKindWater))
+	assert.Equal(t, 0.0, sumIngredientsOfKind(ingredients, ingredientKindYeast))
+}
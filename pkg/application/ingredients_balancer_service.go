@@ -5,49 +5,138 @@ import (
 	"errors"
 	"math"
 
+	"github.com/cfioretti/ingredients-balancer/internal/domain/metrics"
 	"github.com/cfioretti/ingredients-balancer/pkg/domain"
 )
 
-const totalPercentage = 100
+const (
+	totalPercentage    = 100
+	doughWeightPerArea = 0.5
+)
 
-type IngredientsBalancerService struct{}
+type IngredientsBalancerService struct {
+	metrics           metrics.BalancerMetrics
+	substitutionRules domain.SubstitutionCatalog
+}
 
-func NewIngredientsBalancerService() *IngredientsBalancerService {
-	return &IngredientsBalancerService{}
+func NewIngredientsBalancerService(balancerMetrics metrics.BalancerMetrics) *IngredientsBalancerService {
+	return &IngredientsBalancerService{
+		metrics: balancerMetrics,
+	}
 }
 
 func (bs IngredientsBalancerService) Balance(ctx context.Context, recipe domain.Recipe, pans domain.Pans) (*domain.RecipeAggregate, error) {
+	pans, err := resolvePanAreas(pans)
+	if err != nil {
+		return nil, err
+	}
 	if pans.TotalArea <= 0 || getFirstIngredientAmount(recipe.Dough.Ingredients) <= 0 {
 		return nil, errors.New("invalid dough weight")
 	}
 
-	totalDoughWeight := pans.TotalArea / 2
-	doughPercentVariation := totalDoughWeight * recipe.Dough.PercentVariation / 100
-	doughConversionRatio := (totalDoughWeight + doughPercentVariation) / totalPercentage
+	recipe.Dough, err = solveDoughTargets(recipe.Dough)
+	if err != nil {
+		return nil, err
+	}
+
+	weightedPans, err := doughPans(pans)
+	if err != nil {
+		return nil, err
+	}
 	balancedDough := domain.Dough{
 		PercentVariation: recipe.Dough.PercentVariation,
-		Ingredients:      balanceIngredients(recipe.Dough.Ingredients, doughConversionRatio),
+		Ingredients:      balanceIngredients(recipe.Dough.Ingredients, doughConversionRatio(weightedPans.TotalArea, recipe.Dough.PercentVariation)),
+		ProcessLoss:      recipe.Dough.ProcessLoss,
+	}
+
+	portions, err := panToppingPortions(pans)
+	if err != nil {
+		return nil, err
+	}
+	innerArea := toppingArea(pans, portions)
+	balancedTopping, splitToppings, toppingGroups, err := balanceRecipeTopping(recipe, innerArea, portions)
+	if err != nil {
+		return nil, err
+	}
+	balancedLayers, splitLayers, err := balanceToppingLayers(recipe.ToppingLayers, innerArea, portions)
+	if err != nil {
+		return nil, err
 	}
 
-	toppingConversionRatio := pans.TotalArea / recipe.Topping.ReferenceArea
-	balancedTopping := domain.Topping{
-		ReferenceArea: recipe.Topping.ReferenceArea,
-		Ingredients:   balanceIngredients(recipe.Topping.Ingredients, toppingConversionRatio),
+	panPreparation, splitPanPreparation, err := calculatePanPreparation(pans)
+	if err != nil {
+		return nil, err
 	}
 
 	recipeAggregate := &domain.RecipeAggregate{
 		Recipe: recipe,
 		SplitIngredients: domain.SplitIngredients{
-			SplitDough:   calculateSplitDoughs(balancedDough, pans),
-			SplitTopping: []domain.Topping{},
+			SplitDough:          calculateSplitDoughs(balancedDough, weightedPans),
+			SplitTopping:        splitToppings,
+			SplitToppingLayers:  splitLayers,
+			SplitTopCrust:       calculateSplitTopCrusts(balancedDough, pans, weightedPans),
+			SplitPanPreparation: splitPanPreparation,
 		},
+		PanPreparation: panPreparation,
 	}
+	recipeAggregate.SplitIngredients.SplitSlices = calculateSplitSlices(pans, recipeAggregate.SplitIngredients)
 	recipeAggregate.Dough = balancedDough
 	recipeAggregate.Topping = balancedTopping
+	recipeAggregate.ToppingLayers = balancedLayers
+	recipeAggregate.ToppingGroups = toppingGroups
+	recipeAggregate.ToppingArea = round(innerArea)
+
+	if err := applyDoughFormula(recipeAggregate, recipe.Dough); err != nil {
+		return nil, err
+	}
 
 	return recipeAggregate, nil
 }
 
+func doughConversionRatio(totalArea float64, percentVariation float64) float64 {
+	totalDoughWeight := totalArea * doughWeightPerArea
+	doughPercentVariation := totalDoughWeight * percentVariation / 100
+	return (totalDoughWeight + doughPercentVariation) / totalPercentage
+}
+
+func applyDoughFormula(recipeAggregate *domain.RecipeAggregate, recipeDough domain.Dough) error {
+	if recipeDough.Preferment != nil {
+		prefermentSplit, err := splitPreferment(recipeAggregate.Dough, *recipeDough.Preferment)
+		if err != nil {
+			return err
+		}
+		recipeAggregate.PrefermentSplit = prefermentSplit
+	}
+
+	mixDough, lossWeight, err := doughToMix(recipeAggregate.Dough, 1)
+	if err != nil {
+		return err
+	}
+	recipeAggregate.DoughToMix = mixDough
+	recipeAggregate.ProcessLossWeight = lossWeight
+
+	if hasDoughTargets(recipeDough.Targets) {
+		recipeAggregate.SolvedTargets = solvedTargets(recipeAggregate.Dough.Ingredients)
+	}
+
+	if recipeDough.Fermentation != nil {
+		leavening, warning := calculateLeavening(recipeAggregate.Dough.Ingredients, *recipeDough.Fermentation)
+		recipeAggregate.Leavening = leavening
+		if warning != "" {
+			recipeAggregate.Warnings = append(recipeAggregate.Warnings, warning)
+		}
+	}
+
+	if hasStarter(recipeAggregate.Dough.Ingredients) || recipeDough.Targets.Hydration > 0 {
+		recipeAggregate.FormulaPercentages, recipeAggregate.EffectiveHydration = calculateFormula(recipeAggregate.Dough.Ingredients)
+		if warning := hydrationWarning(recipeAggregate.EffectiveHydration, recipeDough.Targets.Hydration); warning != "" {
+			recipeAggregate.Warnings = append(recipeAggregate.Warnings, warning)
+		}
+	}
+
+	return nil
+}
+
 func calculateSplitDoughs(totalDough domain.Dough, pans domain.Pans) []domain.Dough {
 	var splitDoughs []domain.Dough
 
@@ -69,10 +158,8 @@ func calculateSplitDoughs(totalDough domain.Dough, pans domain.Pans) []domain.Do
 func balanceIngredients(ingredients []domain.Ingredient, ratio float64) []domain.Ingredient {
 	balancedIngredients := make([]domain.Ingredient, len(ingredients))
 	for i, ingredient := range ingredients {
-		balancedIngredients[i] = domain.Ingredient{
-			Name:   ingredient.Name,
-			Amount: round(ingredient.Amount * ratio),
-		}
+		balancedIngredients[i] = ingredient
+		balancedIngredients[i].Amount = round(ingredient.Amount * ratio)
 	}
 	return balancedIngredients
 }
@@ -3,13 +3,111 @@ package application
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cfioretti/ingredients-balancer/pkg/domain"
 )
 
+type MockBalancerMetrics struct {
+	mock.Mock
+}
+
+func (m *MockBalancerMetrics) IncrementBalanceOperations(recipeType string) {
+	m.Called(recipeType)
+}
+
+func (m *MockBalancerMetrics) RecordBalanceOperationDuration(recipeType string, duration time.Duration) {
+	m.Called(recipeType, duration)
+}
+
+func (m *MockBalancerMetrics) IncrementBalanceOperationErrors(recipeType string, errorType string) {
+	m.Called(recipeType, errorType)
+}
+
+func (m *MockBalancerMetrics) SetActiveBalanceOperations(count int) {
+	m.Called(count)
+}
+
+func (m *MockBalancerMetrics) IncrementIngredientProcessing(ingredientType string, success bool) {
+	m.Called(ingredientType, success)
+}
+
+func (m *MockBalancerMetrics) RecordIngredientProcessingDuration(ingredientType string, duration time.Duration) {
+	m.Called(ingredientType, duration)
+}
+
+func (m *MockBalancerMetrics) IncrementIngredientOptimizations(optimizationType string) {
+	m.Called(optimizationType)
+}
+
+func (m *MockBalancerMetrics) RecordIngredientWastage(wastePercentage float64) {
+	m.Called(wastePercentage)
+}
+
+func (m *MockBalancerMetrics) IncrementRecipeAnalysis(recipeComplexity string) {
+	m.Called(recipeComplexity)
+}
+
+func (m *MockBalancerMetrics) RecordRecipeAnalysisDuration(duration time.Duration) {
+	m.Called(duration)
+}
+
+func (m *MockBalancerMetrics) IncrementRecipeValidations(validationType string, valid bool) {
+	m.Called(validationType, valid)
+}
+
+func (m *MockBalancerMetrics) RecordRecipePortions(portionCount int) {
+	m.Called(portionCount)
+}
+
+func (m *MockBalancerMetrics) IncrementPanDistributions(panSize string) {
+	m.Called(panSize)
+}
+
+func (m *MockBalancerMetrics) RecordPanDistributionAccuracy(accuracy float64) {
+	m.Called(accuracy)
+}
+
+func (m *MockBalancerMetrics) IncrementPanOptimizations(optimizationType string) {
+	m.Called(optimizationType)
+}
+
+func (m *MockBalancerMetrics) RecordPanUtilization(utilization float64) {
+	m.Called(utilization)
+}
+
+func (m *MockBalancerMetrics) IncrementGRPCRequests(method string, statusCode string) {
+	m.Called(method, statusCode)
+}
+
+func (m *MockBalancerMetrics) RecordGRPCRequestDuration(method string, duration time.Duration) {
+	m.Called(method, duration)
+}
+
+func (m *MockBalancerMetrics) SetActiveGRPCConnections(count int) {
+	m.Called(count)
+}
+
+func (m *MockBalancerMetrics) RecordBalancingAccuracy(accuracy float64) {
+	m.Called(accuracy)
+}
+
+func (m *MockBalancerMetrics) IncrementOptimizationStrategies(strategy string) {
+	m.Called(strategy)
+}
+
+func (m *MockBalancerMetrics) RecordIngredientDistribution(distribution float64) {
+	m.Called(distribution)
+}
+
+func (m *MockBalancerMetrics) IncrementQualityChecks(checkType string, passed bool) {
+	m.Called(checkType, passed)
+}
+
 func TestBalance(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -133,7 +231,7 @@ func TestBalance(t *testing.T) {
 		},
 	}
 
-	balancer := NewIngredientsBalancerService()
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
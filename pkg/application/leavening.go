@@ -0,0 +1,120 @@
+package application
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	yeastTypeFresh           = "fresh"
+	yeastTypeInstant         = "instant"
+	yeastTypeDry             = "dry"
+	yeastPercentagePrecision = 1000
+)
+
+var yeastTypeFactors = map[string]float64{
+	yeastTypeFresh:   1,
+	yeastTypeInstant: 0.33,
+	yeastTypeDry:     0.4,
+}
+
+var (
+	yeastTableTemperatures = []float64{16, 18, 20, 22, 24, 26, 28}
+	yeastTableHours        = []float64{6, 8, 12, 18, 24, 48}
+	freshYeastTable        = [][]float64{
+		{0.938, 0.656, 0.391, 0.234, 0.156, 0.063},
+		{0.750, 0.525, 0.313, 0.188, 0.125, 0.050},
+		{0.600, 0.420, 0.250, 0.150, 0.100, 0.040},
+		{0.480, 0.336, 0.200, 0.120, 0.080, 0.032},
+		{0.384, 0.269, 0.160, 0.096, 0.064, 0.026},
+		{0.307, 0.215, 0.128, 0.077, 0.051, 0.020},
+		{0.246, 0.172, 0.102, 0.061, 0.041, 0.016},
+	}
+)
+
+func yeastType(fermentation domain.Fermentation) string {
+	if fermentation.YeastType == "" {
+		return yeastTypeFresh
+	}
+	return canonicalName(fermentation.YeastType)
+}
+
+func fermentationIssues(fermentation domain.Fermentation) []string {
+	var issues []string
+	if fermentation.Hours <= 0 {
+		issues = append(issues, "fermentation hours must be positive")
+	}
+	if _, ok := yeastTypeFactors[yeastType(fermentation)]; !ok {
+		issues = append(issues, "unknown yeast type: "+fermentation.YeastType)
+	}
+	return issues
+}
+
+func yeastPercentage(fermentation domain.Fermentation) (float64, bool) {
+	temperatureIndex, temperatureWeight, temperatureClamped := tablePosition(yeastTableTemperatures, fermentation.Temperature)
+	hoursIndex, hoursWeight, hoursClamped := tablePosition(yeastTableHours, fermentation.Hours)
+
+	lower := interpolate(freshYeastTable[temperatureIndex][hoursIndex], freshYeastTable[temperatureIndex][hoursIndex+1], hoursWeight)
+	upper := interpolate(freshYeastTable[temperatureIndex+1][hoursIndex], freshYeastTable[temperatureIndex+1][hoursIndex+1], hoursWeight)
+	freshPercentage := interpolate(lower, upper, temperatureWeight)
+
+	return freshPercentage * yeastTypeFactors[yeastType(fermentation)], temperatureClamped || hoursClamped
+}
+
+func tablePosition(axis []float64, value float64) (int, float64, bool) {
+	if value <= axis[0] {
+		return 0, 0, value < axis[0]
+	}
+	last := len(axis) - 1
+	if value >= axis[last] {
+		return last - 1, 1, value > axis[last]
+	}
+
+	index := sort.SearchFloat64s(axis, value) - 1
+	return index, (value - axis[index]) / (axis[index+1] - axis[index]), false
+}
+
+func interpolate(from float64, to float64, weight float64) float64 {
+	return from + (to-from)*weight
+}
+
+func yeastIngredientName(fermentation *domain.Fermentation) string {
+	if fermentation == nil || fermentation.YeastType == "" {
+		return ingredientKindYeast
+	}
+	return yeastType(*fermentation) + " " + ingredientKindYeast
+}
+
+func matchYeastType(ingredients []domain.Ingredient, target string) []domain.Ingredient {
+	for i, ingredient := range ingredients {
+		if ingredientKind(ingredient) != ingredientKindYeast {
+			continue
+		}
+		if source, _ := leaveningType(ingredient); source != target {
+			ingredients[i].Name = leaveningName(target)
+			ingredients[i].Type = ingredientKindYeast
+		}
+	}
+	return ingredients
+}
+
+func calculateLeavening(ingredients []domain.Ingredient, fermentation domain.Fermentation) (*domain.Leavening, string) {
+	percentage, clamped := yeastPercentage(fermentation)
+	freshYeast := sumIngredientsOfKind(ingredients, ingredientKindYeast) / yeastTypeFactors[yeastType(fermentation)]
+
+	var warning string
+	if clamped {
+		warning = fmt.Sprintf("fermentation of %gh at %g°C is outside the yeast table, nearest values used", fermentation.Hours, fermentation.Temperature)
+	}
+
+	return &domain.Leavening{
+		YeastType:       yeastType(fermentation),
+		YeastPercentage: math.Round(percentage*yeastPercentagePrecision) / yeastPercentagePrecision,
+		FreshYeast:      round(freshYeast * yeastTypeFactors[yeastTypeFresh]),
+		InstantYeast:    round(freshYeast * yeastTypeFactors[yeastTypeInstant]),
+		DryYeast:        round(freshYeast * yeastTypeFactors[yeastTypeDry]),
+	}, warning
+}
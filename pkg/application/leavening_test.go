@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestYeastPercentage(t *testing.T) {
+	tests := []struct {
+		name         string
+		fermentation domain.Fermentation
+		want         float64
+		clamped      bool
+	}{
+		{
+			name:         "table value",
+			fermentation: domain.Fermentation{Hours: 12, Temperature: 20},
+			want:         0.25,
+		},
+		{
+			name:         "instant yeast",
+			fermentation: domain.Fermentation{Hours: 12, Temperature: 20, YeastType: "Instant"},
+			want:         0.0825,
+		},
+		{
+			name:         "interpolated between hours and temperatures",
+			fermentation: domain.Fermentation{Hours: 10, Temperature: 21},
+			want:         0.3015,
+		},
+		{
+			name:         "outside table",
+			fermentation: domain.Fermentation{Hours: 72, Temperature: 30},
+			want:         0.016,
+			clamped:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percentage, clamped := yeastPercentage(tt.fermentation)
+
+			assert.InDelta(t, tt.want, percentage, 1e-9)
+			assert.Equal(t, tt.clamped, clamped)
+		})
+	}
+}
+
+func TestFermentationIssues(t *testing.T) {
+	assert.Empty(t, fermentationIssues(domain.Fermentation{Hours: 8, YeastType: "dry"}))
+	assert.Equal(t, []string{
+		"fermentation hours must be positive",
+		"unknown yeast type: wild",
+	}, fermentationIssues(domain.Fermentation{YeastType: "wild"}))
+}
+
+func TestBalance_WithFermentation(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 38},
+				{Name: "salt", Amount: 2},
+			},
+			Fermentation: &domain.Fermentation{Hours: 24, Temperature: 20, YeastType: "dry"},
+		},
+		Topping: domain.Topping{ReferenceArea: 1000},
+	}
+	pans := domain.Pans{
+		TotalArea: 20000,
+		Pans:      []domain.Pan{{Name: "teglia", Area: 20000}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Ingredient{Name: "dry yeast", Amount: 2.4, Type: "yeast"}, result.Dough.Ingredients[3])
+	assert.Equal(t, &domain.Leavening{
+		YeastType:       "dry",
+		YeastPercentage: 0.04,
+		FreshYeast:      6,
+		InstantYeast:    2,
+		DryYeast:        2.4,
+	}, result.Leavening)
+	assert.Nil(t, result.SolvedTargets)
+	assert.Empty(t, result.Warnings)
+
+	freshYeastRecipe := recipe
+	freshYeastRecipe.Dough.Ingredients = append([]domain.Ingredient{{Name: "fresh yeast", Amount: 1.2}}, recipe.Dough.Ingredients...)
+	result, err = balancer.Balance(context.Background(), freshYeastRecipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Ingredient{Name: "dry yeast", Amount: 2.4, Type: "yeast"}, result.Dough.Ingredients[0])
+	assert.Equal(t, 2.4, result.Leavening.DryYeast)
+
+	recipe.Dough.Fermentation = &domain.Fermentation{Hours: 4, Temperature: 20}
+	result, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fermentation of 4h at 20°C is outside the yeast table, nearest values used"}, result.Warnings)
+
+	recipe.Dough.Targets.Yeast = 0.2
+	_, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.EqualError(t, err, "yeast target conflicts with fermentation model")
+}
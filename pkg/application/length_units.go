@@ -0,0 +1,60 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const lengthUnitCentimeter = "cm"
+
+var lengthUnitFactors = map[string]float64{
+	"mm":                 0.1,
+	lengthUnitCentimeter: 1,
+	"inch":               2.54,
+	"in":                 2.54,
+}
+
+func lengthUnitFactor(unit string) (float64, error) {
+	if unit == "" {
+		return 1, nil
+	}
+	factor, ok := lengthUnitFactors[canonicalName(unit)]
+	if !ok {
+		return 0, errors.New("unknown length unit: " + unit)
+	}
+	return factor, nil
+}
+
+func normalizeMeasures(measures domain.Measures) (domain.Measures, error) {
+	factor, err := lengthUnitFactor(measures.Unit)
+	if err != nil {
+		return domain.Measures{}, err
+	}
+
+	normalized := measures
+	normalized.Unit = lengthUnitCentimeter
+	normalized.Diameter = scaleMeasure(measures.Diameter, factor)
+	normalized.Edge = scaleMeasure(measures.Edge, factor)
+	normalized.Width = scaleMeasure(measures.Width, factor)
+	normalized.Length = scaleMeasure(measures.Length, factor)
+	normalized.Height = scaleMeasure(measures.Height, factor)
+	normalized.MajorAxis = scaleMeasure(measures.MajorAxis, factor)
+	normalized.MinorAxis = scaleMeasure(measures.MinorAxis, factor)
+	normalized.CornerRadius = scaleMeasure(measures.CornerRadius, factor)
+	if measures.Vertices != nil {
+		normalized.Vertices = make([]domain.Point, len(measures.Vertices))
+		for i, vertex := range measures.Vertices {
+			normalized.Vertices[i] = domain.Point{X: vertex.X * factor, Y: vertex.Y * factor}
+		}
+	}
+	return normalized, nil
+}
+
+func scaleMeasure(value *float64, factor float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	scaled := *value * factor
+	return &scaled
+}
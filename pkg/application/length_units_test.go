@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestNormalizeMeasures(t *testing.T) {
+	tests := []struct {
+		name     string
+		measures domain.Measures
+		want     domain.Measures
+		wantErr  string
+	}{
+		{
+			name:     "centimeters by default",
+			measures: domain.Measures{Diameter: floatPointer(32.5)},
+			want:     domain.Measures{Unit: "cm", Diameter: floatPointer(32.5)},
+		},
+		{
+			name:     "millimeters",
+			measures: domain.Measures{Unit: "mm", Width: floatPointer(250), Length: floatPointer(355)},
+			want:     domain.Measures{Unit: "cm", Width: floatPointer(25), Length: floatPointer(35.5)},
+		},
+		{
+			name:     "inches",
+			measures: domain.Measures{Unit: "Inch", Diameter: floatPointer(14), Sides: intPointer(6)},
+			want:     domain.Measures{Unit: "cm", Diameter: floatPointer(35.56), Sides: intPointer(6)},
+		},
+		{
+			name:     "outline vertices",
+			measures: domain.Measures{Unit: "mm", Vertices: []domain.Point{{X: 0, Y: 0}, {X: 100, Y: 50}}},
+			want:     domain.Measures{Unit: "cm", Vertices: []domain.Point{{X: 0, Y: 0}, {X: 10, Y: 5}}},
+		},
+		{
+			name:     "unknown unit",
+			measures: domain.Measures{Unit: "furlong", Diameter: floatPointer(1)},
+			wantErr:  "unknown length unit: furlong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := normalizeMeasures(tt.measures)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Unit, result.Unit)
+			assert.Equal(t, tt.want.Sides, result.Sides)
+			for _, pair := range [][2]*float64{
+				{tt.want.Diameter, result.Diameter},
+				{tt.want.Width, result.Width},
+				{tt.want.Length, result.Length},
+			} {
+				if pair[0] == nil {
+					assert.Nil(t, pair[1])
+					continue
+				}
+				assert.InDelta(t, *pair[0], *pair[1], 1e-9)
+			}
+			assert.Equal(t, tt.want.Vertices, result.Vertices)
+		})
+	}
+}
+
+func TestBalance_WithInchMeasures(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+	}
+	pans := domain.Pans{
+		Pans: []domain.Pan{{
+			Name:     "14 inch",
+			Shape:    "round",
+			Measures: domain.Measures{Unit: "inch", Diameter: floatPointer(14)},
+		}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Pi*17.78*17.78*doughWeightPerArea, sumIngredients(result.Dough.Ingredients), 0.1)
+}
+
+func TestBalance_WithInchRimWidth(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	pans := domain.Pans{
+		Pans: []domain.Pan{{
+			Name:     "14 inch",
+			Shape:    "round",
+			RimWidth: 1,
+			Measures: domain.Measures{Unit: "in", Diameter: floatPointer(14)},
+		}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Pi*15.24*15.24, result.ToppingArea, 0.1)
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	amountUnitsPerGram  = 10
+	batchCountTolerance = 1e-9
+	defaultMixerBowls   = 1
+	minimumBatchOverlap = 0.05
+)
+
+func (bs IngredientsBalancerService) PlanMixingBatches(ctx context.Context, recipeAggregate domain.RecipeAggregate, mixer domain.MixerProfile) (*domain.MixingPlan, error) {
+	if mixer.MaxDoughWeight <= 0 || mixer.MinDoughWeight < 0 || mixer.MinDoughWeight > mixer.MaxDoughWeight {
+		return nil, errors.New("invalid mixer profile")
+	}
+
+	targetWeight := sumIngredients(recipeAggregate.Dough.Ingredients)
+	if targetWeight <= 0 {
+		return nil, errors.New("invalid dough weight")
+	}
+
+	mixDough := recipeAggregate.Dough
+	lossWeight := 0.0
+	batchCount := int(math.Ceil(targetWeight/mixer.MaxDoughWeight - batchCountTolerance))
+	if loss := recipeAggregate.Dough.ProcessLoss; loss != nil {
+		if loss.PerBatchLoss >= mixer.MaxDoughWeight {
+			return nil, errors.New("per batch loss exceeds mixer capacity")
+		}
+		for {
+			lossDough, batchLoss, err := doughToMix(recipeAggregate.Dough, batchCount)
+			if err != nil {
+				return nil, err
+			}
+			mixDough = *lossDough
+			lossWeight = batchLoss
+			count := int(math.Ceil(sumIngredients(mixDough.Ingredients)/mixer.MaxDoughWeight - batchCountTolerance))
+			if count <= batchCount {
+				break
+			}
+			batchCount = count
+		}
+	}
+
+	totalDoughWeight := sumIngredients(mixDough.Ingredients)
+	if totalDoughWeight/float64(batchCount) < mixer.MinDoughWeight {
+		return nil, errors.New("dough weight cannot be split into batches within mixer capacity")
+	}
+
+	bowlCount := mixer.BowlCount
+	if bowlCount <= 0 {
+		bowlCount = defaultMixerBowls
+	}
+
+	shares := make([]float64, batchCount)
+	for i := range shares {
+		shares[i] = 1
+	}
+
+	batches := make([]domain.MixingBatch, batchCount)
+	for i := range batches {
+		batches[i] = domain.MixingBatch{
+			Number:      i + 1,
+			Bowl:        i%bowlCount + 1,
+			Ingredients: make([]domain.Ingredient, len(mixDough.Ingredients)),
+		}
+	}
+	remainderOffset := 0
+	for j, ingredient := range mixDough.Ingredients {
+		for i, amount := range distributeExact(ingredient.Amount, shares) {
+			batchIndex := (i + remainderOffset) % batchCount
+			batches[batchIndex].Ingredients[j] = ingredient
+			batches[batchIndex].Ingredients[j].Amount = amount
+		}
+		remainderOffset += int(math.Round(ingredient.Amount*amountUnitsPerGram)) % batchCount
+	}
+
+	panRatio := targetWeight / totalDoughWeight
+	batchStart := 0.0
+	for i := range batches {
+		batches[i].DoughWeight = round(sumIngredients(batches[i].Ingredients))
+		batches[i].Pans = assignPansToBatch(recipeAggregate.SplitIngredients.SplitDough, batchStart*panRatio, (batchStart+batches[i].DoughWeight)*panRatio)
+		batchStart += batches[i].DoughWeight
+	}
+
+	plan := &domain.MixingPlan{Batches: batches}
+	if recipeAggregate.Dough.ProcessLoss != nil {
+		plan.DoughToMix = &mixDough
+		plan.ProcessLossWeight = lossWeight
+	}
+	return plan, nil
+}
+
+func assignPansToBatch(splitDoughs []domain.Dough, batchStart, batchEnd float64) []domain.PanPortion {
+	var portions []domain.PanPortion
+
+	panStart := 0.0
+	for _, splitDough := range splitDoughs {
+		panEnd := panStart + sumIngredients(splitDough.Ingredients)
+		overlap := math.Min(panEnd, batchEnd) - math.Max(panStart, batchStart)
+		if overlap >= minimumBatchOverlap {
+			portions = append(portions, domain.PanPortion{
+				Name:        splitDough.Name,
+				DoughWeight: round(overlap),
+			})
+		}
+		panStart = panEnd
+	}
+
+	return portions
+}
+
+func distributeExact(amount float64, weights []float64) []float64 {
+	distributed := make([]float64, len(weights))
+
+	totalWeight := 0.0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight <= 0 {
+		return distributed
+	}
+
+	totalUnits := int(math.Round(amount * amountUnitsPerGram))
+	units := make([]int, len(weights))
+	remainders := make([]float64, len(weights))
+	assignedUnits := 0
+	for i, weight := range weights {
+		exactUnits := float64(totalUnits) * weight / totalWeight
+		units[i] = int(math.Floor(exactUnits))
+		remainders[i] = exactUnits - float64(units[i])
+		assignedUnits += units[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; i < totalUnits-assignedUnits; i++ {
+		units[order[i%len(order)]]++
+	}
+
+	for i, unit := range units {
+		distributed[i] = float64(unit) / amountUnitsPerGram
+	}
+	return distributed
+}
+
+func sumIngredients(ingredients []domain.Ingredient) float64 {
+	total := 0.0
+	for _, ingredient := range ingredients {
+		total += ingredient.Amount
+	}
+	return total
+}
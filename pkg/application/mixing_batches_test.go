@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestPlanMixingBatches(t *testing.T) {
+	recipeAggregate := domain.RecipeAggregate{
+		Recipe: domain.Recipe{
+			Dough: domain.Dough{
+				Ingredients: []domain.Ingredient{
+					{Name: "flour", Amount: 29999.5},
+					{Name: "water", Amount: 21000},
+					{Name: "yeast", Amount: 0.5},
+				},
+			},
+		},
+		SplitIngredients: domain.SplitIngredients{
+			SplitDough: []domain.Dough{
+				{Name: "pan A", Ingredients: []domain.Ingredient{{Name: "flour", Amount: 20000}}},
+				{Name: "pan B", Ingredients: []domain.Ingredient{{Name: "flour", Amount: 20000}}},
+				{Name: "pan C", Ingredients: []domain.Ingredient{{Name: "flour", Amount: 11000}}},
+			},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("splits dough within mixer capacity", func(t *testing.T) {
+		mixer := domain.MixerProfile{MaxDoughWeight: 25000, MinDoughWeight: 5000, BowlCount: 2}
+
+		plan, err := balancer.PlanMixingBatches(context.Background(), recipeAggregate, mixer)
+
+		assert.NoError(t, err)
+		assert.Nil(t, plan.DoughToMix)
+		batches := plan.Batches
+		assert.Len(t, batches, 3)
+		assert.Equal(t, []int{1, 2, 1}, []int{batches[0].Bowl, batches[1].Bowl, batches[2].Bowl})
+
+		for _, batch := range batches {
+			assert.LessOrEqual(t, batch.DoughWeight, mixer.MaxDoughWeight)
+			assert.GreaterOrEqual(t, batch.DoughWeight, mixer.MinDoughWeight)
+		}
+
+		for j, ingredient := range recipeAggregate.Dough.Ingredients {
+			total := 0.0
+			for _, batch := range batches {
+				total += batch.Ingredients[j].Amount
+			}
+			assert.InDelta(t, ingredient.Amount, total, 1e-9)
+		}
+
+		assert.Equal(t, []domain.PanPortion{{Name: "pan A", DoughWeight: 17000}}, batches[0].Pans)
+		assert.Equal(t, []domain.PanPortion{
+			{Name: "pan A", DoughWeight: 3000},
+			{Name: "pan B", DoughWeight: 14000},
+		}, batches[1].Pans)
+		assert.Equal(t, []domain.PanPortion{
+			{Name: "pan B", DoughWeight: 6000},
+			{Name: "pan C", DoughWeight: 11000},
+		}, batches[2].Pans)
+	})
+
+	t.Run("single batch when dough fits the mixer", func(t *testing.T) {
+		mixer := domain.MixerProfile{MaxDoughWeight: 60000}
+
+		plan, err := balancer.PlanMixingBatches(context.Background(), recipeAggregate, mixer)
+
+		assert.NoError(t, err)
+		batches := plan.Batches
+		assert.Len(t, batches, 1)
+		assert.Equal(t, 1, batches[0].Bowl)
+		assert.Equal(t, 51000.0, batches[0].DoughWeight)
+		assert.Len(t, batches[0].Pans, 3)
+	})
+
+	t.Run("dough below mixer minimum", func(t *testing.T) {
+		mixer := domain.MixerProfile{MaxDoughWeight: 100000, MinDoughWeight: 60000}
+
+		plan, err := balancer.PlanMixingBatches(context.Background(), recipeAggregate, mixer)
+
+		assert.Error(t, err)
+		assert.Nil(t, plan)
+	})
+
+	t.Run("invalid mixer profile", func(t *testing.T) {
+		plan, err := balancer.PlanMixingBatches(context.Background(), recipeAggregate, domain.MixerProfile{})
+
+		assert.Error(t, err)
+		assert.Nil(t, plan)
+	})
+}
+
+func TestDistributeExact(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  float64
+		weights []float64
+		want    []float64
+	}{
+		{
+			name:    "equal shares with remainder",
+			amount:  0.5,
+			weights: []float64{1, 1, 1},
+			want:    []float64{0.2, 0.2, 0.1},
+		},
+		{
+			name:    "weighted shares",
+			amount:  100,
+			weights: []float64{3, 1},
+			want:    []float64{75, 25},
+		},
+		{
+			name:    "largest remainder wins",
+			amount:  1,
+			weights: []float64{1, 2},
+			want:    []float64{0.3, 0.7},
+		},
+		{
+			name:    "zero weights",
+			amount:  10,
+			weights: []float64{0, 0},
+			want:    []float64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, distributeExact(tt.amount, tt.weights))
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	panShapeOval             = "oval"
+	panShapeEllipse          = "ellipse"
+	panShapePolygon          = "polygon"
+	panShapeRoundedRectangle = "rounded_rectangle"
+	panShapeCustom           = "custom"
+	geometryTolerance        = 1e-9
+)
+
+type panGeometry struct {
+	area      float64
+	perimeter float64
+}
+
+func isGeometricShape(shape string) bool {
+	switch shape {
+	case panShapeOval, panShapeEllipse, panShapePolygon, panShapeRoundedRectangle, panShapeCustom:
+		return true
+	}
+	return false
+}
+
+func measure(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func calculatePanGeometry(pan domain.Pan) (panGeometry, error) {
+	measures := pan.Measures
+	switch pan.Shape {
+	case "round", "circular":
+		radius := measure(measures.Diameter) / 2
+		if radius <= 0 {
+			return panGeometry{}, errors.New("invalid diameter for " + pan.Name)
+		}
+		return panGeometry{area: math.Pi * radius * radius, perimeter: 2 * math.Pi * radius}, nil
+	case "square":
+		edge := measure(measures.Edge)
+		if edge <= 0 {
+			return panGeometry{}, errors.New("invalid edge for " + pan.Name)
+		}
+		return panGeometry{area: edge * edge, perimeter: 4 * edge}, nil
+	case "rectangular":
+		width, length := measure(measures.Width), measure(measures.Length)
+		if width <= 0 || length <= 0 {
+			return panGeometry{}, errors.New("invalid width or length for " + pan.Name)
+		}
+		return panGeometry{area: width * length, perimeter: 2 * (width + length)}, nil
+	case panShapeOval, panShapeEllipse:
+		return ellipseGeometry(pan.Name, measure(measures.MajorAxis)/2, measure(measures.MinorAxis)/2)
+	case panShapePolygon:
+		return regularPolygonGeometry(pan.Name, measures.Sides, measure(measures.Edge))
+	case panShapeRoundedRectangle:
+		return roundedRectangleGeometry(pan.Name, measure(measures.Width), measure(measures.Length), measure(measures.CornerRadius))
+	case panShapeCustom:
+		return outlineGeometry(pan.Name, measures.Vertices)
+	default:
+		return panGeometry{}, errors.New("unknown pan shape: " + pan.Shape)
+	}
+}
+
+func ellipseGeometry(name string, a, b float64) (panGeometry, error) {
+	if a <= 0 || b <= 0 {
+		return panGeometry{}, errors.New("invalid axes for " + name)
+	}
+	h := math.Pow(a-b, 2) / math.Pow(a+b, 2)
+	return panGeometry{
+		area:      math.Pi * a * b,
+		perimeter: math.Pi * (a + b) * (1 + 3*h/(10+math.Sqrt(4-3*h))),
+	}, nil
+}
+
+func regularPolygonGeometry(name string, sides *int, edge float64) (panGeometry, error) {
+	if sides == nil || *sides < 3 {
+		return panGeometry{}, errors.New("a polygon needs at least 3 sides for " + name)
+	}
+	if edge <= 0 {
+		return panGeometry{}, errors.New("invalid edge for " + name)
+	}
+	n := float64(*sides)
+	return panGeometry{
+		area:      n * edge * edge / (4 * math.Tan(math.Pi/n)),
+		perimeter: n * edge,
+	}, nil
+}
+
+func roundedRectangleGeometry(name string, width, length, cornerRadius float64) (panGeometry, error) {
+	if width <= 0 || length <= 0 {
+		return panGeometry{}, errors.New("invalid width or length for " + name)
+	}
+	if cornerRadius < 0 || 2*cornerRadius > math.Min(width, length) {
+		return panGeometry{}, errors.New("invalid corner radius for " + name)
+	}
+	return panGeometry{
+		area:      width*length - (4-math.Pi)*cornerRadius*cornerRadius,
+		perimeter: 2*(width+length) - 8*cornerRadius + 2*math.Pi*cornerRadius,
+	}, nil
+}
+
+func outlineGeometry(name string, vertices []domain.Point) (panGeometry, error) {
+	if len(vertices) < 3 {
+		return panGeometry{}, errors.New("an outline needs at least 3 vertices for " + name)
+	}
+
+	if isSelfIntersecting(vertices) {
+		return panGeometry{}, errors.New("outline is self-intersecting for " + name)
+	}
+
+	var area, perimeter float64
+	for i, vertex := range vertices {
+		next := vertices[(i+1)%len(vertices)]
+		area += vertex.X*next.Y - next.X*vertex.Y
+		perimeter += math.Hypot(next.X-vertex.X, next.Y-vertex.Y)
+	}
+	area = math.Abs(area) / 2
+	if area <= geometryTolerance {
+		return panGeometry{}, errors.New("outline has no area for " + name)
+	}
+	return panGeometry{area: area, perimeter: perimeter}, nil
+}
+
+func isSelfIntersecting(vertices []domain.Point) bool {
+	n := len(vertices)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+			if segmentsIntersect(vertices[i], vertices[(i+1)%n], vertices[j], vertices[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func segmentsIntersect(p1, p2, q1, q2 domain.Point) bool {
+	d1 := orientation(q1, q2, p1)
+	d2 := orientation(q1, q2, p2)
+	d3 := orientation(p1, p2, q1)
+	d4 := orientation(p1, p2, q2)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(q1, q2, p1)) ||
+		(d2 == 0 && onSegment(q1, q2, p2)) ||
+		(d3 == 0 && onSegment(p1, p2, q1)) ||
+		(d4 == 0 && onSegment(p1, p2, q2))
+}
+
+func orientation(a, b, c domain.Point) float64 {
+	value := (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+	if math.Abs(value) <= geometryTolerance {
+		return 0
+	}
+	return value
+}
+
+func onSegment(a, b, p domain.Point) bool {
+	return p.X >= math.Min(a.X, b.X)-geometryTolerance && p.X <= math.Max(a.X, b.X)+geometryTolerance &&
+		p.Y >= math.Min(a.Y, b.Y)-geometryTolerance && p.Y <= math.Max(a.Y, b.Y)+geometryTolerance
+}
+
+func resolvePanArea(pan domain.Pan) (domain.Pan, error) {
+	factor, err := lengthUnitFactor(pan.Measures.Unit)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	pan.RimWidth *= factor
+	pan.Measures, err = normalizeMeasures(pan.Measures)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	pan.Slices, err = panSliceCount(pan)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	if pan.Shape == "" || (pan.Area > 0 && !isGeometricShape(pan.Shape)) {
+		return pan, nil
+	}
+	geometry, err := calculatePanGeometry(pan)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	if pan.Area <= 0 {
+		pan.Area = geometry.area
+	}
+	return pan, nil
+}
+
+func resolvePanAreas(pans domain.Pans) (domain.Pans, error) {
+	resolved := pans
+	resolved.Pans = make([]domain.Pan, len(pans.Pans))
+	computedArea := 0.0
+	for i, pan := range pans.Pans {
+		var err error
+		resolved.Pans[i], err = resolvePanArea(pan)
+		if err != nil {
+			return domain.Pans{}, err
+		}
+		computedArea += resolved.Pans[i].Area
+	}
+	if resolved.TotalArea <= 0 {
+		resolved.TotalArea = computedArea
+	}
+	return resolved, nil
+}
+
+func resolveCandidateAreas(candidates []domain.PanCandidate) ([]domain.PanCandidate, error) {
+	resolved := make([]domain.PanCandidate, len(candidates))
+	for i, candidate := range candidates {
+		pan, err := resolvePanArea(candidate.Pan)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = domain.PanCandidate{Pan: pan, MaxQuantity: candidate.MaxQuantity}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestCalculatePanGeometry(t *testing.T) {
+	tests := []struct {
+		name          string
+		pan           domain.Pan
+		wantArea      float64
+		wantPerimeter float64
+		wantErr       string
+	}{
+		{
+			name:          "round pan",
+			pan:           domain.Pan{Shape: "round", Measures: domain.Measures{Diameter: floatPointer(30)}},
+			wantArea:      math.Pi * 225,
+			wantPerimeter: math.Pi * 30,
+		},
+		{
+			name:          "oval pan",
+			pan:           domain.Pan{Shape: "oval", Measures: domain.Measures{MajorAxis: floatPointer(40), MinorAxis: floatPointer(30)}},
+			wantArea:      math.Pi * 300,
+			wantPerimeter: 110.5175,
+		},
+		{
+			name:          "hexagonal pan",
+			pan:           domain.Pan{Shape: "polygon", Measures: domain.Measures{Sides: intPointer(6), Edge: floatPointer(10)}},
+			wantArea:      259.8076,
+			wantPerimeter: 60,
+		},
+		{
+			name: "rounded rectangle",
+			pan: domain.Pan{
+				Shape:    "rounded_rectangle",
+				Measures: domain.Measures{Width: floatPointer(30), Length: floatPointer(40), CornerRadius: floatPointer(5)},
+			},
+			wantArea:      1178.5398,
+			wantPerimeter: 131.4159,
+		},
+		{
+			name: "custom outline",
+			pan: domain.Pan{
+				Shape: "custom",
+				Measures: domain.Measures{Vertices: []domain.Point{
+					{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 20}, {X: 20, Y: 20}, {X: 20, Y: 40}, {X: 0, Y: 40},
+				}},
+			},
+			wantArea:      1200,
+			wantPerimeter: 160,
+		},
+		{
+			name: "self-intersecting outline",
+			pan: domain.Pan{
+				Name:     "bowtie",
+				Shape:    "custom",
+				Measures: domain.Measures{Vertices: []domain.Point{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 10, Y: 0}, {X: 0, Y: 10}}},
+			},
+			wantErr: "outline is self-intersecting for bowtie",
+		},
+		{
+			name: "collinear outline",
+			pan: domain.Pan{
+				Name:     "line",
+				Shape:    "custom",
+				Measures: domain.Measures{Vertices: []domain.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}}},
+			},
+			wantErr: "outline has no area for line",
+		},
+		{
+			name:    "outline with too few vertices",
+			pan:     domain.Pan{Name: "tray", Shape: "custom", Measures: domain.Measures{Vertices: []domain.Point{{X: 0, Y: 0}, {X: 1, Y: 0}}}},
+			wantErr: "an outline needs at least 3 vertices for tray",
+		},
+		{
+			name:    "polygon with too few sides",
+			pan:     domain.Pan{Name: "tray", Shape: "polygon", Measures: domain.Measures{Sides: intPointer(2), Edge: floatPointer(10)}},
+			wantErr: "a polygon needs at least 3 sides for tray",
+		},
+		{
+			name: "corner radius too large",
+			pan: domain.Pan{
+				Name:     "tray",
+				Shape:    "rounded_rectangle",
+				Measures: domain.Measures{Width: floatPointer(30), Length: floatPointer(40), CornerRadius: floatPointer(20)},
+			},
+			wantErr: "invalid corner radius for tray",
+		},
+		{
+			name:    "oval without axes",
+			pan:     domain.Pan{Name: "ovale", Shape: "oval"},
+			wantErr: "invalid axes for ovale",
+		},
+		{
+			name:    "unknown shape",
+			pan:     domain.Pan{Shape: "star"},
+			wantErr: "unknown pan shape: star",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calculatePanGeometry(tt.pan)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.wantArea, result.area, 1e-4)
+			assert.InDelta(t, tt.wantPerimeter, result.perimeter, 1e-4)
+		})
+	}
+}
+
+func TestBalance_WithComputedPanAreas(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("area computed from the outline", func(t *testing.T) {
+		pans := domain.Pans{
+			Pans: []domain.Pan{{
+				Name:  "tray",
+				Shape: "custom",
+				Measures: domain.Measures{Vertices: []domain.Point{
+					{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 20}, {X: 20, Y: 20}, {X: 20, Y: 40}, {X: 0, Y: 40},
+				}},
+			}},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 600.0, sumIngredients(result.Dough.Ingredients))
+	})
+
+	t.Run("self-intersecting outline", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 100,
+			Pans: []domain.Pan{{
+				Name:     "bowtie",
+				Shape:    "custom",
+				Area:     100,
+				Measures: domain.Measures{Vertices: []domain.Point{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 10, Y: 0}, {X: 0, Y: 10}}},
+			}},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.EqualError(t, err, "outline is self-intersecting for bowtie")
+		assert.Nil(t, result)
+	})
+}
+
+func floatPointer(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,182 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	servingsOptimizationType = "servings"
+	leftoverTolerance        = 1e-9
+)
+
+type servingsState struct {
+	area      float64
+	pans      int
+	reachable bool
+}
+
+func (bs IngredientsBalancerService) OptimizePans(ctx context.Context, recipe domain.Recipe, catalog []domain.PanCandidate, target domain.ServingsTarget) (*domain.PanOptimizationResult, error) {
+	if target.Servings <= 0 {
+		return nil, errors.New("invalid servings target")
+	}
+
+	catalog, err := resolveCandidateAreas(catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe.Dough, err = solveDoughTargets(recipe.Dough)
+	if err != nil {
+		return nil, err
+	}
+
+	doughWeightPerArea := sumIngredients(recipe.Dough.Ingredients) * doughConversionRatio(1, recipe.Dough.PercentVariation)
+	if doughWeightPerArea <= 0 {
+		return nil, errors.New("invalid dough weight")
+	}
+
+	slices := make([]int, len(catalog))
+	areas := make([]float64, len(catalog))
+	maxSlices := 0
+	for i, candidate := range catalog {
+		if candidate.Pan.Area <= 0 {
+			return nil, errors.New("invalid pan area for " + candidate.Pan.Name)
+		}
+		areas[i], err = panDoughArea(candidate.Pan)
+		if err != nil {
+			return nil, err
+		}
+		slices[i] = candidateSlices(target.SlicesPerShape, candidate.Pan)
+		if slices[i] <= 0 {
+			return nil, errors.New("no slices per pan defined for shape " + candidate.Pan.Shape)
+		}
+		maxSlices = max(maxSlices, slices[i])
+	}
+	capacity := target.Servings + maxSlices - 1
+
+	var chunks []panChunk
+	for i, candidate := range catalog {
+		quantity := capacity / slices[i]
+		if candidate.MaxQuantity != nil && *candidate.MaxQuantity < quantity {
+			quantity = *candidate.MaxQuantity
+		}
+		for size := 1; quantity > 0; size *= 2 {
+			chunkQuantity := min(size, quantity)
+			chunks = append(chunks, panChunk{candidate: i, quantity: chunkQuantity, units: chunkQuantity * slices[i]})
+			quantity -= chunkQuantity
+		}
+	}
+
+	byArea, takenByArea := coverServings(areas, chunks, capacity, isSmallerArea)
+	byPans, takenByPans := coverServings(areas, chunks, capacity, isFewerPans)
+
+	best := -1
+	bestLeftover := math.Inf(1)
+	bestPans := 0
+	var bestTaken [][]bool
+	for c := target.Servings; c <= capacity; c++ {
+		state, taken := byArea[c], takenByArea
+		if c == target.Servings {
+			state, taken = byPans[c], takenByPans
+		}
+		if !state.reachable {
+			continue
+		}
+		leftover := state.area * doughWeightPerArea * float64(c-target.Servings) / float64(c)
+		if leftover < bestLeftover-leftoverTolerance ||
+			(math.Abs(leftover-bestLeftover) <= leftoverTolerance && state.pans < bestPans) {
+			best = c
+			bestLeftover = leftover
+			bestPans = state.pans
+			bestTaken = taken
+		}
+	}
+	if best < 0 {
+		return nil, errors.New("servings target cannot be reached with the available pans")
+	}
+
+	servings := best
+	quantities := make([]int, len(catalog))
+	for k := len(chunks) - 1; k >= 0; k-- {
+		if bestTaken[k][best] {
+			quantities[chunks[k].candidate] += chunks[k].quantity
+			best -= chunks[k].units
+		}
+	}
+
+	var selections []domain.PanSelection
+	for i, quantity := range quantities {
+		if quantity > 0 {
+			selections = append(selections, domain.PanSelection{Pan: catalog[i].Pan, Quantity: quantity})
+		}
+	}
+
+	recipeAggregate, err := bs.Balance(ctx, recipe, toPans(selections))
+	if err != nil {
+		return nil, err
+	}
+
+	leftoverDough := sumIngredients(recipeAggregate.Dough.Ingredients) * float64(servings-target.Servings) / float64(servings)
+	utilization := round(float64(target.Servings) / float64(servings) * 100)
+	bs.metrics.IncrementPanOptimizations(servingsOptimizationType)
+	bs.metrics.RecordPanUtilization(utilization)
+
+	return &domain.PanOptimizationResult{
+		Assortment: domain.PanAssortment{
+			Selections:    selections,
+			Servings:      servings,
+			LeftoverDough: round(leftoverDough),
+			Utilization:   utilization,
+		},
+		RecipeAggregate: *recipeAggregate,
+	}, nil
+}
+
+func candidateSlices(slicesPerShape map[string]int, pan domain.Pan) int {
+	for shape, slices := range slicesPerShape {
+		if canonicalName(shape) == canonicalName(pan.Shape) {
+			return slices
+		}
+	}
+	return pan.Slices
+}
+
+func coverServings(areas []float64, chunks []panChunk, capacity int, isBetter func(candidate, current servingsState) bool) ([]servingsState, [][]bool) {
+	states := make([]servingsState, capacity+1)
+	states[0].reachable = true
+	taken := make([][]bool, len(chunks))
+	for k, chunk := range chunks {
+		taken[k] = make([]bool, capacity+1)
+		chunkArea := float64(chunk.quantity) * areas[chunk.candidate]
+		for c := capacity; c >= chunk.units; c-- {
+			previous := states[c-chunk.units]
+			if !previous.reachable {
+				continue
+			}
+			candidate := servingsState{area: previous.area + chunkArea, pans: previous.pans + chunk.quantity, reachable: true}
+			if !states[c].reachable || isBetter(candidate, states[c]) {
+				states[c] = candidate
+				taken[k][c] = true
+			}
+		}
+	}
+	return states, taken
+}
+
+func isSmallerArea(candidate, current servingsState) bool {
+	if candidate.area != current.area {
+		return candidate.area < current.area
+	}
+	return candidate.pans < current.pans
+}
+
+func isFewerPans(candidate, current servingsState) bool {
+	if candidate.pans != current.pans {
+		return candidate.pans < current.pans
+	}
+	return candidate.area < current.area
+}
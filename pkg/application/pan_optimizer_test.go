@@ -0,0 +1,187 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestOptimizePans(t *testing.T) {
+	recipe := domain.Recipe{
+		Name: "Test Recipe",
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 40},
+			},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	catalog := []domain.PanCandidate{
+		{Pan: domain.Pan{Shape: "rectangular", Name: "teglia", Area: 1200}},
+		{Pan: domain.Pan{Shape: "round", Name: "tonda", Area: 700}},
+	}
+	slicesPerShape := map[string]int{"rectangular": 12, "round": 8, "square": 8}
+
+	tests := []struct {
+		name            string
+		catalog         []domain.PanCandidate
+		servings        int
+		wantSelections  map[string]int
+		wantServings    int
+		wantLeftover    float64
+		wantUtilization float64
+	}{
+		{
+			name:            "exact servings with the fewest pans",
+			catalog:         catalog,
+			servings:        120,
+			wantSelections:  map[string]int{"teglia": 10},
+			wantServings:    120,
+			wantLeftover:    0,
+			wantUtilization: 100,
+		},
+		{
+			name:            "minimises leftover dough",
+			catalog:         catalog,
+			servings:        30,
+			wantSelections:  map[string]int{"tonda": 4},
+			wantServings:    32,
+			wantLeftover:    87.5,
+			wantUtilization: 93.8,
+		},
+		{
+			name: "respects max quantities",
+			catalog: []domain.PanCandidate{
+				{Pan: domain.Pan{Shape: "rectangular", Name: "teglia", Area: 1200}},
+				{Pan: domain.Pan{Shape: "round", Name: "tonda", Area: 700}, MaxQuantity: intPointer(1)},
+			},
+			servings:        30,
+			wantSelections:  map[string]int{"teglia": 2, "tonda": 1},
+			wantServings:    32,
+			wantLeftover:    96.9,
+			wantUtilization: 93.8,
+		},
+		{
+			name: "matches shapes case-insensitively",
+			catalog: []domain.PanCandidate{
+				{Pan: domain.Pan{Shape: "Round", Name: "tonda", Area: 700}},
+			},
+			servings:        16,
+			wantSelections:  map[string]int{"tonda": 2},
+			wantServings:    16,
+			wantLeftover:    0,
+			wantUtilization: 100,
+		},
+		{
+			name: "falls back to the slicing of the pan",
+			catalog: []domain.PanCandidate{
+				{Pan: domain.Pan{Shape: "pala", Name: "pala", Area: 1000, Slicing: &domain.SlicingPattern{Pattern: "grid", Rows: 2, Columns: 5}}},
+			},
+			servings:        20,
+			wantSelections:  map[string]int{"pala": 2},
+			wantServings:    20,
+			wantLeftover:    0,
+			wantUtilization: 100,
+		},
+		{
+			name: "weighs leftover by the thickness of the pans",
+			catalog: []domain.PanCandidate{
+				{Pan: domain.Pan{Shape: "square", Name: "focaccia", Area: 1000, ThicknessMultiplier: 2}},
+			},
+			servings:        5,
+			wantSelections:  map[string]int{"focaccia": 1},
+			wantServings:    8,
+			wantLeftover:    375,
+			wantUtilization: 62.5,
+		},
+		{
+			name: "weighs leftover by the dough area of deep pans",
+			catalog: []domain.PanCandidate{
+				{Pan: domain.Pan{Shape: "round", Name: "deep", Deep: true, Measures: domain.Measures{Diameter: floatPointer(25), Height: floatPointer(5)}}},
+			},
+			servings:        6,
+			wantSelections:  map[string]int{"deep": 1},
+			wantServings:    8,
+			wantLeftover:    110.5,
+			wantUtilization: 75,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &MockBalancerMetrics{}
+			metrics.On("IncrementPanOptimizations", "servings").Return()
+			metrics.On("RecordPanUtilization", tt.wantUtilization).Return()
+			balancer := NewIngredientsBalancerService(metrics)
+
+			result, err := balancer.OptimizePans(context.Background(), recipe, tt.catalog, domain.ServingsTarget{
+				Servings:       tt.servings,
+				SlicesPerShape: slicesPerShape,
+			})
+
+			assert.NoError(t, err)
+
+			selections := make(map[string]int)
+			for _, selection := range result.Assortment.Selections {
+				selections[selection.Pan.Name] = selection.Quantity
+			}
+			assert.Equal(t, tt.wantSelections, selections)
+			assert.Equal(t, tt.wantServings, result.Assortment.Servings)
+			assert.Equal(t, tt.wantLeftover, result.Assortment.LeftoverDough)
+			assert.Equal(t, tt.wantUtilization, result.Assortment.Utilization)
+			assert.NotEmpty(t, result.RecipeAggregate.SplitIngredients.SplitDough)
+
+			metrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOptimizePans_Errors(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		catalog []domain.PanCandidate
+		target  domain.ServingsTarget
+	}{
+		{
+			name:    "invalid servings",
+			catalog: []domain.PanCandidate{{Pan: domain.Pan{Shape: "round", Area: 700}}},
+			target:  domain.ServingsTarget{SlicesPerShape: map[string]int{"round": 8}},
+		},
+		{
+			name:    "shape without slices",
+			catalog: []domain.PanCandidate{{Pan: domain.Pan{Shape: "square", Area: 900}}},
+			target:  domain.ServingsTarget{Servings: 10, SlicesPerShape: map[string]int{"round": 8}},
+		},
+		{
+			name:    "target not reachable",
+			catalog: []domain.PanCandidate{{Pan: domain.Pan{Shape: "round", Area: 700}, MaxQuantity: intPointer(1)}},
+			target:  domain.ServingsTarget{Servings: 30, SlicesPerShape: map[string]int{"round": 8}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &MockBalancerMetrics{}
+			balancer := NewIngredientsBalancerService(metrics)
+
+			result, err := balancer.OptimizePans(context.Background(), recipe, tt.catalog, tt.target)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			metrics.AssertNotCalled(t, "IncrementPanOptimizations", "servings")
+		})
+	}
+}
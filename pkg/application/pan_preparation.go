@@ -0,0 +1,85 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func findPanProfile(profiles []domain.PanProfile, pan domain.Pan) (*domain.PanProfile, error) {
+	if pan.Profile != "" {
+		for i := range profiles {
+			if canonicalName(profiles[i].Name) == canonicalName(pan.Profile) {
+				return &profiles[i], nil
+			}
+		}
+		return nil, errors.New("unknown pan profile: " + pan.Profile)
+	}
+
+	var (
+		best      *domain.PanProfile
+		bestScore = -1
+	)
+	for i, profile := range profiles {
+		if score := panProfileScore(profile, pan); score > bestScore {
+			best = &profiles[i]
+			bestScore = score
+		}
+	}
+	return best, nil
+}
+
+func panProfileScore(profile domain.PanProfile, pan domain.Pan) int {
+	score := 0
+	if profile.Material != "" {
+		if canonicalName(profile.Material) != canonicalName(pan.Material) {
+			return -1
+		}
+		score++
+	}
+	if profile.Style != "" {
+		if canonicalName(profile.Style) != canonicalName(pan.Style) {
+			return -1
+		}
+		score++
+	}
+	return score
+}
+
+func calculatePanPreparation(pans domain.Pans) ([]domain.Ingredient, []domain.PanPreparation, error) {
+	if len(pans.Profiles) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		total        []domain.Ingredient
+		preparations []domain.PanPreparation
+	)
+	for _, pan := range pans.Pans {
+		profile, err := findPanProfile(pans.Profiles, pan)
+		if err != nil {
+			return nil, nil, err
+		}
+		if profile == nil {
+			continue
+		}
+		if profile.ReferenceArea <= 0 {
+			return nil, nil, errors.New("invalid reference area for pan profile " + profile.Name)
+		}
+
+		area, err := panSurfaceArea(pan)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ingredients := balanceIngredients(profile.Ingredients, area/profile.ReferenceArea)
+		preparations = append(preparations, domain.PanPreparation{
+			Name:        pan.Name,
+			Profile:     profile.Name,
+			Ingredients: ingredients,
+		})
+		total = mergeIngredients(total, ingredients)
+	}
+
+	return total, preparations, nil
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithPanPreparation(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+	}
+	profiles := []domain.PanProfile{
+		{Name: "default", ReferenceArea: 100, Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 0.5}}},
+		{Name: "teglia romana", Material: "blue steel", Style: "roman", ReferenceArea: 100, Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 1.5}}},
+		{Name: "detroit", Style: "detroit", ReferenceArea: 100, Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 2}}},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("adds pan preparation per pan and in total", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 2775,
+			Pans: []domain.Pan{
+				{Name: "teglia", Area: 1200, Material: "Blue Steel", Style: "roman"},
+				{Name: "tonda", Area: 700},
+				{Name: "detroit", Area: 875, Profile: "Detroit"},
+			},
+			Profiles: profiles,
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PanPreparation{
+			{Name: "teglia", Profile: "teglia romana", Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 18}}},
+			{Name: "tonda", Profile: "default", Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 3.5}}},
+			{Name: "detroit", Profile: "detroit", Ingredients: []domain.Ingredient{{Name: "evoOil", Amount: 17.5}}},
+		}, result.SplitIngredients.SplitPanPreparation)
+		assert.Equal(t, []domain.Ingredient{{Name: "evoOil", Amount: 39}}, result.PanPreparation)
+		assert.Equal(t, 1387.5, sumIngredients(result.Dough.Ingredients))
+
+		shoppingList, err := balancer.GenerateShoppingList(context.Background(), []domain.RecipeAggregate{*result}, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Contains(t, shoppingList.Items, domain.ShoppingItem{Name: "evooil", RequiredAmount: 39, NeededAmount: 39, PurchaseAmount: 39})
+	})
+
+	t.Run("pans without a matching profile", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 700,
+			Pans:      []domain.Pan{{Name: "tonda", Area: 700, Style: "neapolitan"}},
+			Profiles:  profiles[1:],
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.PanPreparation)
+		assert.Nil(t, result.SplitIngredients.SplitPanPreparation)
+	})
+
+	t.Run("unknown pan profile", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 700,
+			Pans:      []domain.Pan{{Name: "tonda", Area: 700, Profile: "cast iron"}},
+			Profiles:  profiles,
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.EqualError(t, err, "unknown pan profile: cast iron")
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const sectionFractionTolerance = 1e-6
+
+func panSectionPortions(pan domain.Pan, area float64) ([]toppingPortion, error) {
+	portions := make([]toppingPortion, 0, len(pan.Sections))
+	totalFraction := 0.0
+	for _, section := range pan.Sections {
+		if section.Fraction <= 0 {
+			return nil, errors.New("invalid section fraction for " + pan.Name + ": " + section.Name)
+		}
+		totalFraction += section.Fraction
+
+		recipe := section.ToppingRecipe
+		if recipe == "" {
+			recipe = pan.ToppingRecipe
+		}
+		portions = append(portions, toppingPortion{
+			name:   pan.Name + " - " + section.Name,
+			area:   area * section.Fraction,
+			count:  1,
+			share:  section.Fraction,
+			slices: pan.Slices,
+			recipe: recipe,
+		})
+	}
+	if math.Abs(totalFraction-1) > sectionFractionTolerance {
+		return nil, errors.New("section fractions of " + pan.Name + " must sum to 1")
+	}
+	return portions, nil
+}
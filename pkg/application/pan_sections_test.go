@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithPanSections(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			Name:          "margherita",
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}, {Name: "mozzarella", Amount: 200}},
+		},
+		ToppingRecipes: []domain.Topping{
+			{
+				Name:          "capricciosa",
+				ReferenceArea: 1000,
+				Ingredients: []domain.Ingredient{
+					{Name: "tomato", Amount: 300},
+					{Name: "ham", Amount: 150},
+					{Name: "olive", Amount: 1, Scaling: "per_slice"},
+				},
+			},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("half and half pan", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 1000,
+			Pans: []domain.Pan{
+				{
+					Name:   "tonda",
+					Area:   600,
+					Slices: 8,
+					Sections: []domain.PanSection{
+						{Name: "margherita", Fraction: 0.5},
+						{Name: "capricciosa", Fraction: 0.5, ToppingRecipe: "capricciosa"},
+					},
+				},
+				{Name: "teglia", Area: 400},
+			},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.SplitIngredients.SplitDough, 2)
+		assert.Equal(t, 300.0, sumIngredients(result.SplitIngredients.SplitDough[0].Ingredients))
+
+		splitToppings := result.SplitIngredients.SplitTopping
+		assert.Len(t, splitToppings, 3)
+		assert.Equal(t, "tonda - margherita", splitToppings[0].Name)
+		assert.Equal(t, []domain.Ingredient{{Name: "tomato", Amount: 90}, {Name: "mozzarella", Amount: 60}}, splitToppings[0].Ingredients)
+		assert.Equal(t, "tonda - capricciosa", splitToppings[1].Name)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "tomato", Amount: 90},
+			{Name: "ham", Amount: 45},
+			{Name: "olive", Amount: 4, Scaling: "per_slice"},
+		}, splitToppings[1].Ingredients)
+		assert.Equal(t, "teglia", splitToppings[2].Name)
+		assert.Equal(t, []domain.Ingredient{{Name: "tomato", Amount: 120}, {Name: "mozzarella", Amount: 80}}, splitToppings[2].Ingredients)
+
+		assert.Equal(t, []string{"tonda - margherita", "teglia"}, result.ToppingGroups[0].Pans)
+		assert.Equal(t, 700.0, result.ToppingGroups[0].Area)
+		assert.Equal(t, 300.0, result.ToppingGroups[1].Area)
+	})
+
+	tests := []struct {
+		name     string
+		sections []domain.PanSection
+		wantErr  string
+	}{
+		{
+			name:     "fractions not summing to one",
+			sections: []domain.PanSection{{Name: "margherita", Fraction: 0.5}, {Name: "capricciosa", Fraction: 0.3}},
+			wantErr:  "section fractions of tonda must sum to 1",
+		},
+		{
+			name:     "non positive fraction",
+			sections: []domain.PanSection{{Name: "margherita", Fraction: 1}, {Name: "capricciosa", Fraction: 0}},
+			wantErr:  "invalid section fraction for tonda: capricciosa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pans := domain.Pans{
+				TotalArea: 600,
+				Pans:      []domain.Pan{{Name: "tonda", Area: 600, Sections: tt.sections}},
+			}
+
+			result, err := balancer.Balance(context.Background(), recipe, pans)
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Nil(t, result)
+		})
+	}
+}
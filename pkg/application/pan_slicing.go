@@ -0,0 +1,82 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	slicingGrid   = "grid"
+	slicingRadial = "radial"
+)
+
+func panSliceCount(pan domain.Pan) (int, error) {
+	if pan.Slicing == nil {
+		return pan.Slices, nil
+	}
+	switch canonicalName(pan.Slicing.Pattern) {
+	case slicingGrid:
+		if pan.Slicing.Rows <= 0 || pan.Slicing.Columns <= 0 {
+			return 0, errors.New("invalid slicing grid for " + pan.Name)
+		}
+		return pan.Slicing.Rows * pan.Slicing.Columns, nil
+	case slicingRadial:
+		if pan.Slicing.Slices <= 0 {
+			return 0, errors.New("invalid radial slicing for " + pan.Name)
+		}
+		return pan.Slicing.Slices, nil
+	default:
+		return 0, errors.New("unknown slicing pattern: " + pan.Slicing.Pattern)
+	}
+}
+
+func calculateSplitSlices(pans domain.Pans, split domain.SplitIngredients) []domain.PanSlices {
+	var panSlices []domain.PanSlices
+	portion := 0
+	for i, pan := range pans.Pans {
+		portionCount := max(len(pan.Sections), 1)
+		first := portion
+		portion += portionCount
+		if pan.Slices <= 0 {
+			continue
+		}
+
+		var dough []domain.Ingredient
+		if i < len(split.SplitDough) {
+			dough = split.SplitDough[i].Ingredients
+		}
+
+		var topping []domain.Ingredient
+		for p := first; p < portion; p++ {
+			if p < len(split.SplitTopping) {
+				topping = mergeIngredients(topping, split.SplitTopping[p].Ingredients)
+			}
+			if p < len(split.SplitToppingLayers) {
+				for _, layer := range split.SplitToppingLayers[p].Layers {
+					topping = mergeIngredients(topping, layer.Ingredients)
+				}
+			}
+		}
+
+		sliceDough := perSliceIngredients(dough, pan.Slices)
+		sliceTopping := perSliceIngredients(topping, pan.Slices)
+		panSlices = append(panSlices, domain.PanSlices{
+			Name:    pan.Name,
+			Count:   pan.Slices,
+			Weight:  round(sumIngredients(sliceDough) + sumIngredients(sliceTopping)),
+			Dough:   sliceDough,
+			Topping: sliceTopping,
+		})
+	}
+	return panSlices
+}
+
+func perSliceIngredients(ingredients []domain.Ingredient, slices int) []domain.Ingredient {
+	perSlice := make([]domain.Ingredient, len(ingredients))
+	for i, ingredient := range ingredients {
+		perSlice[i] = ingredient
+		perSlice[i].Amount = round(ingredient.Amount / float64(slices))
+	}
+	return perSlice
+}
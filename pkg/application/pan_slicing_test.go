@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithSlicingPatterns(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("per-slice amounts for grid and radial patterns", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 1100,
+			Pans: []domain.Pan{
+				{Name: "teglia", Area: 600, Slicing: &domain.SlicingPattern{Pattern: "grid", Rows: 2, Columns: 4}},
+				{Name: "tonda", Area: 400, Slicing: &domain.SlicingPattern{Pattern: "radial", Slices: 6}},
+				{Name: "assaggio", Area: 100},
+			},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PanSlices{
+			{
+				Name:    "teglia",
+				Count:   8,
+				Weight:  60,
+				Dough:   []domain.Ingredient{{Name: "flour", Amount: 22.5}, {Name: "water", Amount: 15}},
+				Topping: []domain.Ingredient{{Name: "tomato", Amount: 22.5}},
+			},
+			{
+				Name:    "tonda",
+				Count:   6,
+				Weight:  53.3,
+				Dough:   []domain.Ingredient{{Name: "flour", Amount: 20}, {Name: "water", Amount: 13.3}},
+				Topping: []domain.Ingredient{{Name: "tomato", Amount: 20}},
+			},
+		}, result.SplitIngredients.SplitSlices)
+	})
+
+	t.Run("stuffed pan counts its top crust once", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 600,
+			Pans: []domain.Pan{{
+				Name:          "chicago",
+				Area:          600,
+				TopCrustShare: 0.5,
+				Slicing:       &domain.SlicingPattern{Pattern: "radial", Slices: 6},
+			}},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 450.0, sumIngredients(result.SplitIngredients.SplitDough[0].Ingredients))
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 45}, {Name: "water", Amount: 30}}, result.SplitIngredients.SplitSlices[0].Dough)
+		assert.Equal(t, 105.0, result.SplitIngredients.SplitSlices[0].Weight)
+	})
+
+	t.Run("pattern drives per slice topping scaling", func(t *testing.T) {
+		perSlice := recipe
+		perSlice.Topping = domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "basil leaves", Amount: 2, Scaling: "per_slice"}},
+		}
+		pans := domain.Pans{
+			TotalArea: 600,
+			Pans:      []domain.Pan{{Name: "teglia", Area: 600, Slices: 4, Slicing: &domain.SlicingPattern{Pattern: "grid", Rows: 3, Columns: 4}}},
+		}
+
+		result, err := balancer.Balance(context.Background(), perSlice, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 24.0, result.Topping.Ingredients[0].Amount)
+		assert.Equal(t, 12, result.SplitIngredients.SplitSlices[0].Count)
+		assert.Equal(t, 2.0, result.SplitIngredients.SplitSlices[0].Topping[0].Amount)
+	})
+
+	tests := []struct {
+		name    string
+		slicing domain.SlicingPattern
+		wantErr string
+	}{
+		{name: "empty grid", slicing: domain.SlicingPattern{Pattern: "grid", Rows: 2}, wantErr: "invalid slicing grid for teglia"},
+		{name: "no radial slices", slicing: domain.SlicingPattern{Pattern: "radial"}, wantErr: "invalid radial slicing for teglia"},
+		{name: "unknown pattern", slicing: domain.SlicingPattern{Pattern: "diagonal"}, wantErr: "unknown slicing pattern: diagonal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pans := domain.Pans{
+				TotalArea: 600,
+				Pans:      []domain.Pan{{Name: "teglia", Area: 600, Slicing: &tt.slicing}},
+			}
+
+			result, err := balancer.Balance(context.Background(), recipe, pans)
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Nil(t, result)
+		})
+	}
+}
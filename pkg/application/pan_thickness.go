@@ -0,0 +1,19 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const defaultThicknessMultiplier = 1
+
+func thicknessMultiplier(pan domain.Pan) (float64, error) {
+	if pan.ThicknessMultiplier < 0 {
+		return 0, errors.New("invalid thickness multiplier for " + pan.Name)
+	}
+	if pan.ThicknessMultiplier == 0 {
+		return defaultThicknessMultiplier, nil
+	}
+	return pan.ThicknessMultiplier, nil
+}
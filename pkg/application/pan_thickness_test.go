@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithThicknessMultipliers(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			PercentVariation: 10,
+			Ingredients:      []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("weights dough by pan thickness", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 1000,
+			Pans: []domain.Pan{
+				{Name: "focaccia", Area: 600, ThicknessMultiplier: 2},
+				{Name: "sottile", Area: 400, ThicknessMultiplier: 0.5},
+			},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 770.0, sumIngredients(result.Dough.Ingredients))
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 396}, {Name: "water", Amount: 264}}, result.SplitIngredients.SplitDough[0].Ingredients)
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 66}, {Name: "water", Amount: 44}}, result.SplitIngredients.SplitDough[1].Ingredients)
+		assert.Equal(t, 300.0, result.Topping.Ingredients[0].Amount)
+	})
+
+	t.Run("negative thickness multiplier", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 600,
+			Pans:      []domain.Pan{{Name: "focaccia", Area: 600, ThicknessMultiplier: -1}},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.EqualError(t, err, "invalid thickness multiplier for focaccia")
+		assert.Nil(t, result)
+	})
+}
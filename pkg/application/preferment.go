@@ -0,0 +1,70 @@
+package application
+
+import (
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const defaultPrefermentName = "preferment"
+
+func splitPreferment(dough domain.Dough, preferment domain.Preferment) (*domain.PrefermentSplit, error) {
+	if preferment.FlourPercentage <= 0 || preferment.FlourPercentage > totalPercentage || preferment.Hydration <= 0 || preferment.YeastPercentage < 0 {
+		return nil, errors.New("invalid preferment")
+	}
+
+	totalFlour := sumIngredientsOfKind(dough.Ingredients, ingredientKindFlour)
+	totalWater := sumIngredientsOfKind(dough.Ingredients, ingredientKindWater)
+	totalYeast := sumIngredientsOfKind(dough.Ingredients, ingredientKindYeast)
+	if totalFlour <= 0 {
+		return nil, errors.New("preferment requires flour in the dough")
+	}
+
+	prefermentFlour := totalFlour * preferment.FlourPercentage / 100
+	prefermentWater := prefermentFlour * preferment.Hydration / 100
+	prefermentYeast := prefermentFlour * preferment.YeastPercentage / 100
+	if prefermentWater > totalWater {
+		return nil, errors.New("preferment water exceeds dough water")
+	}
+	if prefermentYeast > totalYeast {
+		return nil, errors.New("preferment yeast exceeds dough yeast")
+	}
+
+	shares := map[string]float64{
+		ingredientKindFlour: prefermentFlour / totalFlour,
+	}
+	if totalWater > 0 {
+		shares[ingredientKindWater] = prefermentWater / totalWater
+	}
+	if totalYeast > 0 {
+		shares[ingredientKindYeast] = prefermentYeast / totalYeast
+	}
+
+	name := preferment.Type
+	if name == "" {
+		name = defaultPrefermentName
+	}
+
+	prefermentDough := domain.Dough{Name: name}
+	finalDough := domain.Dough{Name: "final dough"}
+	for _, ingredient := range dough.Ingredients {
+		prefermentAmount := round(ingredient.Amount * shares[ingredientKind(ingredient)])
+		if prefermentAmount > 0 {
+			prefermentIngredient := ingredient
+			prefermentIngredient.Amount = prefermentAmount
+			prefermentDough.Ingredients = append(prefermentDough.Ingredients, prefermentIngredient)
+		}
+		finalIngredient := ingredient
+		finalIngredient.Amount = round(ingredient.Amount - prefermentAmount)
+		finalDough.Ingredients = append(finalDough.Ingredients, finalIngredient)
+	}
+	finalDough.Ingredients = append(finalDough.Ingredients, domain.Ingredient{
+		Name:   name,
+		Amount: round(sumIngredients(prefermentDough.Ingredients)),
+	})
+
+	return &domain.PrefermentSplit{
+		Preferment: prefermentDough,
+		FinalDough: finalDough,
+	}, nil
+}
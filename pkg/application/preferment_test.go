@@ -0,0 +1,133 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestSplitPreferment(t *testing.T) {
+	t.Run("poolish takes flour, water and yeast from the total dough", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "Farina", Amount: 1000},
+				{Name: "Acqua", Amount: 700},
+				{Name: "Sale", Amount: 25},
+				{Name: "Lievito", Amount: 5},
+			},
+		}
+
+		result, err := splitPreferment(dough, domain.Preferment{
+			Type:            "poolish",
+			Hydration:       100,
+			FlourPercentage: 30,
+			YeastPercentage: 0.1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "poolish", result.Preferment.Name)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "Farina", Amount: 300},
+			{Name: "Acqua", Amount: 300},
+			{Name: "Lievito", Amount: 0.3},
+		}, result.Preferment.Ingredients)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "Farina", Amount: 700},
+			{Name: "Acqua", Amount: 400},
+			{Name: "Sale", Amount: 25},
+			{Name: "Lievito", Amount: 4.7},
+			{Name: "poolish", Amount: 600.3},
+		}, result.FinalDough.Ingredients)
+	})
+
+	t.Run("biga keeps the flour blend", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour type 0", Amount: 800},
+				{Name: "whole wheat flour", Amount: 200},
+				{Name: "water", Amount: 650},
+				{Name: "fresh yeast", Amount: 5},
+			},
+		}
+
+		result, err := splitPreferment(dough, domain.Preferment{
+			Hydration:       45,
+			FlourPercentage: 50,
+			YeastPercentage: 1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "preferment", result.Preferment.Name)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "flour type 0", Amount: 400},
+			{Name: "whole wheat flour", Amount: 100},
+			{Name: "water", Amount: 225},
+			{Name: "fresh yeast", Amount: 5},
+		}, result.Preferment.Ingredients)
+		assert.Equal(t, 0.0, result.FinalDough.Ingredients[3].Amount)
+		assert.Equal(t, 730.0, result.FinalDough.Ingredients[4].Amount)
+	})
+
+	t.Run("invalid preferments", func(t *testing.T) {
+		dough := domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 1000},
+				{Name: "water", Amount: 700},
+				{Name: "yeast", Amount: 1},
+			},
+		}
+
+		invalidPreferments := []domain.Preferment{
+			{Hydration: 100},
+			{Hydration: 100, FlourPercentage: 120},
+			{Hydration: 100, FlourPercentage: 100},
+			{Hydration: 50, FlourPercentage: 50, YeastPercentage: 1},
+		}
+
+		for _, preferment := range invalidPreferments {
+			result, err := splitPreferment(dough, preferment)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		}
+	})
+}
+
+func TestBalance_WithPreferment(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 39},
+				{Name: "salt", Amount: 1},
+			},
+			Preferment: &domain.Preferment{Type: "biga", Hydration: 50, FlourPercentage: 40},
+		},
+		Topping: domain.Topping{ReferenceArea: 1000},
+	}
+	pans := domain.Pans{
+		TotalArea: 2000,
+		Pans:      []domain.Pan{{Name: "teglia", Area: 2000}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.PrefermentSplit)
+	assert.Equal(t, 240.0, result.PrefermentSplit.Preferment.Ingredients[0].Amount)
+	assert.Equal(t, 120.0, result.PrefermentSplit.Preferment.Ingredients[1].Amount)
+	assert.Equal(t, 360.0, result.PrefermentSplit.FinalDough.Ingredients[0].Amount)
+	assert.Equal(t, 270.0, result.PrefermentSplit.FinalDough.Ingredients[1].Amount)
+	assert.Equal(t, "biga", result.PrefermentSplit.FinalDough.Ingredients[3].Name)
+	assert.Equal(t, 360.0, result.PrefermentSplit.FinalDough.Ingredients[3].Amount)
+
+	recipe.Dough.Preferment = &domain.Preferment{Hydration: 100, FlourPercentage: 100}
+	result, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func doughToMix(dough domain.Dough, batches int) (*domain.Dough, float64, error) {
+	loss := dough.ProcessLoss
+	if loss == nil {
+		return nil, 0, nil
+	}
+	if loss.MixerPercentage < 0 || loss.MinimumLoss < 0 || loss.PerBatchLoss < 0 {
+		return nil, 0, errors.New("invalid process loss")
+	}
+
+	targetWeight := sumIngredients(dough.Ingredients)
+	if targetWeight <= 0 {
+		return nil, 0, errors.New("invalid dough weight")
+	}
+
+	lossWeight := math.Max(targetWeight*loss.MixerPercentage/100, loss.MinimumLoss) + loss.PerBatchLoss*float64(max(batches, 1))
+	return &domain.Dough{
+		Name:             dough.Name,
+		PercentVariation: dough.PercentVariation,
+		Ingredients:      balanceIngredients(dough.Ingredients, (targetWeight+lossWeight)/targetWeight),
+	}, round(lossWeight), nil
+}
+
+func amountBeforeProcessLoss(dough domain.Dough, ingredientName string, amount float64) (float64, error) {
+	loss := dough.ProcessLoss
+	if loss == nil {
+		return amount, nil
+	}
+	if loss.MixerPercentage < 0 || loss.MinimumLoss < 0 || loss.PerBatchLoss < 0 {
+		return 0, errors.New("invalid process loss")
+	}
+
+	targetWeight := sumIngredients(dough.Ingredients)
+	if targetWeight <= 0 {
+		return 0, errors.New("invalid dough weight")
+	}
+
+	share := 0.0
+	for _, ingredient := range dough.Ingredients {
+		if canonicalName(ingredient.Name) == canonicalName(ingredientName) {
+			share += ingredient.Amount / targetWeight
+		}
+	}
+	return math.Min(
+		(amount-share*loss.PerBatchLoss)/(1+loss.MixerPercentage/100),
+		amount-share*(loss.MinimumLoss+loss.PerBatchLoss),
+	), nil
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithProcessLoss(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+			ProcessLoss: &domain.ProcessLoss{MixerPercentage: 2, MinimumLoss: 30, PerBatchLoss: 10},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	tests := []struct {
+		name       string
+		totalArea  float64
+		wantToMix  []domain.Ingredient
+		wantLoss   float64
+		wantTarget float64
+	}{
+		{
+			name:       "minimum loss",
+			totalArea:  1000,
+			wantToMix:  []domain.Ingredient{{Name: "flour", Amount: 324}, {Name: "water", Amount: 216}},
+			wantLoss:   40,
+			wantTarget: 500,
+		},
+		{
+			name:       "mixer percentage",
+			totalArea:  10000,
+			wantToMix:  []domain.Ingredient{{Name: "flour", Amount: 3066}, {Name: "water", Amount: 2044}},
+			wantLoss:   110,
+			wantTarget: 5000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pans := domain.Pans{TotalArea: tt.totalArea, Pans: []domain.Pan{{Name: "teglia", Area: tt.totalArea}}}
+
+			result, err := balancer.Balance(context.Background(), recipe, pans)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTarget, sumIngredients(result.Dough.Ingredients))
+			assert.Equal(t, tt.wantTarget, sumIngredients(result.SplitIngredients.SplitDough[0].Ingredients))
+			assert.Equal(t, tt.wantToMix, result.DoughToMix.Ingredients)
+			assert.Equal(t, tt.wantLoss, result.ProcessLossWeight)
+		})
+	}
+
+	t.Run("without process loss", func(t *testing.T) {
+		withoutLoss := recipe
+		withoutLoss.Dough.ProcessLoss = nil
+		pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "teglia", Area: 1000}}}
+
+		result, err := balancer.Balance(context.Background(), withoutLoss, pans)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.DoughToMix)
+		assert.Zero(t, result.ProcessLossWeight)
+	})
+
+	t.Run("invalid process loss", func(t *testing.T) {
+		invalid := recipe
+		invalid.Dough.ProcessLoss = &domain.ProcessLoss{MinimumLoss: -5}
+		pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "teglia", Area: 1000}}}
+
+		result, err := balancer.Balance(context.Background(), invalid, pans)
+
+		assert.EqualError(t, err, "invalid process loss")
+		assert.Nil(t, result)
+	})
+
+	t.Run("mixing batches and shopping list use the amount to mix", func(t *testing.T) {
+		pans := domain.Pans{TotalArea: 10000, Pans: []domain.Pan{{Name: "teglia", Area: 10000}}}
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+		assert.NoError(t, err)
+
+		plan, err := balancer.PlanMixingBatches(context.Background(), *result, domain.MixerProfile{MaxDoughWeight: 2555})
+
+		assert.NoError(t, err)
+		assert.Len(t, plan.Batches, 3)
+		totalMixed, totalOnPans := 0.0, 0.0
+		for _, batch := range plan.Batches {
+			totalMixed += batch.DoughWeight
+			for _, pan := range batch.Pans {
+				totalOnPans += pan.DoughWeight
+			}
+		}
+		assert.InDelta(t, 5130, totalMixed, 0.1)
+		assert.InDelta(t, 5000, totalOnPans, 0.2)
+		assert.Equal(t, []domain.Ingredient{{Name: "flour", Amount: 3078}, {Name: "water", Amount: 2052}}, plan.DoughToMix.Ingredients)
+		assert.Equal(t, 130.0, plan.ProcessLossWeight)
+
+		result.MixingBatches = plan.Batches
+		result.DoughToMix = plan.DoughToMix
+		result.ProcessLossWeight = plan.ProcessLossWeight
+		shoppingList, err := balancer.GenerateShoppingList(context.Background(), []domain.RecipeAggregate{*result}, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5130.0, shoppingList.Items[0].RequiredAmount+shoppingList.Items[1].RequiredAmount)
+	})
+}
@@ -0,0 +1,244 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	minimumAreaUnit   = 0.1
+	maximumAreaStates = 200000
+)
+
+type panChunk struct {
+	candidate int
+	quantity  int
+	units     int
+}
+
+func (bs IngredientsBalancerService) ReverseBalance(ctx context.Context, recipe domain.Recipe, candidates []domain.PanCandidate, limitingIngredient domain.Ingredient) (*domain.ReverseBalanceResult, error) {
+	if limitingIngredient.Amount <= 0 {
+		return nil, errors.New("invalid limiting ingredient amount")
+	}
+
+	candidates, err := resolveCandidateAreas(candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe.Dough, err = solveDoughTargets(recipe.Dough)
+	if err != nil {
+		return nil, err
+	}
+
+	amountPerArea, inDough, err := ingredientAmountPerArea(recipe, candidates, limitingIngredient.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	areas, err := candidateAreas(candidates, inDough)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := limitingIngredient.Amount
+	if inDough {
+		limit, err = amountBeforeProcessLoss(recipe.Dough, limitingIngredient.Name, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	selections, err := selectPans(candidates, areas, limit/amountPerArea)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeAggregate, err := bs.Balance(ctx, recipe, toPans(selections))
+	if err != nil {
+		return nil, err
+	}
+
+	usedAmount := findIngredientAmount(*recipeAggregate, limitingIngredient.Name)
+	return &domain.ReverseBalanceResult{
+		Selections:      selections,
+		RecipeAggregate: *recipeAggregate,
+		UsedAmount:      usedAmount,
+		LeftoverAmount:  round(limitingIngredient.Amount - usedAmount),
+	}, nil
+}
+
+func ingredientAmountPerArea(recipe domain.Recipe, candidates []domain.PanCandidate, ingredientName string) (float64, bool, error) {
+	name := canonicalName(ingredientName)
+
+	for _, ingredient := range recipe.Dough.Ingredients {
+		if canonicalName(ingredient.Name) == name && ingredient.Amount > 0 {
+			return ingredient.Amount * doughConversionRatio(1, recipe.Dough.PercentVariation), true, nil
+		}
+	}
+
+	for _, topping := range recipe.ToppingRecipes {
+		if !usesToppingRecipe(candidates, topping.Name) {
+			continue
+		}
+		for _, ingredient := range topping.Ingredients {
+			if canonicalName(ingredient.Name) == name {
+				return 0, false, errors.New("limiting ingredient in per-pan topping recipes is not supported: " + ingredientName)
+			}
+		}
+	}
+
+	amountPerArea := 0.0
+	for _, topping := range recipeToppings(recipe) {
+		for _, ingredient := range topping.Ingredients {
+			if canonicalName(ingredient.Name) == name && ingredient.Amount > 0 && topping.ReferenceArea > 0 {
+				if ingredientScaling(topping, ingredient) != toppingScalingArea {
+					return 0, false, errors.New("limiting ingredient does not scale with pan area: " + ingredientName)
+				}
+				amountPerArea += ingredient.Amount / topping.ReferenceArea
+			}
+		}
+	}
+	if amountPerArea > 0 {
+		return amountPerArea, false, nil
+	}
+
+	return 0, false, errors.New("limiting ingredient not found in recipe: " + ingredientName)
+}
+
+func usesToppingRecipe(candidates []domain.PanCandidate, recipeName string) bool {
+	for _, candidate := range candidates {
+		if canonicalName(candidate.Pan.ToppingRecipe) == canonicalName(recipeName) {
+			return true
+		}
+		for _, section := range candidate.Pan.Sections {
+			if canonicalName(section.ToppingRecipe) == canonicalName(recipeName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func candidateAreas(candidates []domain.PanCandidate, inDough bool) ([]float64, error) {
+	areas := make([]float64, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.Pan.Area <= 0 {
+			return nil, errors.New("invalid pan area for " + candidate.Pan.Name)
+		}
+		var err error
+		if inDough {
+			areas[i], err = panDoughArea(candidate.Pan)
+		} else {
+			areas[i], err = innerPanArea(candidate.Pan, candidate.Pan.RimWidth)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return areas, nil
+}
+
+func selectPans(candidates []domain.PanCandidate, areas []float64, maxArea float64) ([]domain.PanSelection, error) {
+	areaUnit := math.Max(minimumAreaUnit, maxArea/maximumAreaStates)
+	capacity := int(math.Floor(math.Max(maxArea, 0) / areaUnit))
+
+	var chunks []panChunk
+	for i, candidate := range candidates {
+		units := int(math.Ceil(areas[i] / areaUnit))
+		if units > capacity {
+			continue
+		}
+		quantity := capacity / units
+		if candidate.MaxQuantity != nil && *candidate.MaxQuantity < quantity {
+			quantity = *candidate.MaxQuantity
+		}
+		for size := 1; quantity > 0; size *= 2 {
+			chunkQuantity := min(size, quantity)
+			chunks = append(chunks, panChunk{candidate: i, quantity: chunkQuantity, units: chunkQuantity * units})
+			quantity -= chunkQuantity
+		}
+	}
+
+	panCounts := make([]int, capacity+1)
+	for c := 1; c <= capacity; c++ {
+		panCounts[c] = math.MaxInt
+	}
+	taken := make([][]bool, len(chunks))
+	for k, chunk := range chunks {
+		taken[k] = make([]bool, capacity+1)
+		for c := capacity; c >= chunk.units; c-- {
+			previous := panCounts[c-chunk.units]
+			if previous != math.MaxInt && previous+chunk.quantity < panCounts[c] {
+				panCounts[c] = previous + chunk.quantity
+				taken[k][c] = true
+			}
+		}
+	}
+
+	best := capacity
+	for best > 0 && panCounts[best] == math.MaxInt {
+		best--
+	}
+	if best == 0 {
+		return nil, errors.New("limiting ingredient is not enough for any pan")
+	}
+
+	quantities := make([]int, len(candidates))
+	for k := len(chunks) - 1; k >= 0; k-- {
+		if taken[k][best] {
+			quantities[chunks[k].candidate] += chunks[k].quantity
+			best -= chunks[k].units
+		}
+	}
+
+	var selections []domain.PanSelection
+	for i, quantity := range quantities {
+		if quantity > 0 {
+			selections = append(selections, domain.PanSelection{Pan: candidates[i].Pan, Quantity: quantity})
+		}
+	}
+	return selections, nil
+}
+
+func toPans(selections []domain.PanSelection) domain.Pans {
+	var pans domain.Pans
+	for _, selection := range selections {
+		for i := 1; i <= selection.Quantity; i++ {
+			pan := selection.Pan
+			if selection.Quantity > 1 {
+				pan.Name = fmt.Sprintf("%s #%d", selection.Pan.Name, i)
+			}
+			pans.Pans = append(pans.Pans, pan)
+			pans.TotalArea += pan.Area
+		}
+	}
+	return pans
+}
+
+func findIngredientAmount(recipeAggregate domain.RecipeAggregate, ingredientName string) float64 {
+	name := canonicalName(ingredientName)
+
+	dough := recipeAggregate.Dough
+	if recipeAggregate.DoughToMix != nil {
+		dough = *recipeAggregate.DoughToMix
+	}
+	for _, ingredient := range dough.Ingredients {
+		if canonicalName(ingredient.Name) == name {
+			return ingredient.Amount
+		}
+	}
+	amount := 0.0
+	for _, topping := range recipeToppings(recipeAggregate.Recipe) {
+		for _, ingredient := range topping.Ingredients {
+			if canonicalName(ingredient.Name) == name {
+				amount += ingredient.Amount
+			}
+		}
+	}
+	return round(amount)
+}
@@ -0,0 +1,264 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestReverseBalance(t *testing.T) {
+	recipe := domain.Recipe{
+		Name: "Test Recipe",
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 55.7},
+				{Name: "water", Amount: 41.6},
+				{Name: "salt", Amount: 1.1},
+				{Name: "evoOil", Amount: 1.1},
+				{Name: "yeast", Amount: 0.5},
+			},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients: []domain.Ingredient{
+				{Name: "tomato", Amount: 300},
+				{Name: "mozzarella", Amount: 200},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		candidates         []domain.PanCandidate
+		limitingIngredient domain.Ingredient
+		wantSelections     map[string]int
+		wantUsed           float64
+		wantLeftover       float64
+	}{
+		{
+			name: "maximises area covered by the available flour",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "large", Area: 1500}, MaxQuantity: intPointer(1)},
+				{Pan: domain.Pan{Name: "small", Area: 700}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "Flour", Amount: 1000},
+			wantSelections:     map[string]int{"small": 5},
+			wantUsed:           974.8,
+			wantLeftover:       25.2,
+		},
+		{
+			name: "prefers fewer pans for the same area",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "large", Area: 1000}},
+				{Pan: domain.Pan{Name: "small", Area: 500}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 560},
+			wantSelections:     map[string]int{"large": 2},
+			wantUsed:           557,
+			wantLeftover:       3,
+		},
+		{
+			name: "respects max quantities",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "large", Area: 1000}, MaxQuantity: intPointer(1)},
+				{Pan: domain.Pan{Name: "small", Area: 500}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 560},
+			wantSelections:     map[string]int{"large": 1, "small": 2},
+			wantUsed:           557,
+			wantLeftover:       3,
+		},
+		{
+			name: "limited by a topping ingredient",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "medium", Area: 700}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "mozzarella", Amount: 300},
+			wantSelections:     map[string]int{"medium": 2},
+			wantUsed:           280,
+			wantLeftover:       20,
+		},
+		{
+			name: "sizes deep pans by their dough area",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "deep", Shape: "round", Deep: true, Measures: domain.Measures{Diameter: floatPointer(25), Height: floatPointer(5)}}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 1000},
+			wantSelections:     map[string]int{"deep": 4},
+			wantUsed:           984.3,
+			wantLeftover:       15.7,
+		},
+		{
+			name: "sizes thick pans by their dough weight",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "focaccia", Area: 500, ThicknessMultiplier: 2}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 1000},
+			wantSelections:     map[string]int{"focaccia": 3},
+			wantUsed:           835.5,
+			wantLeftover:       164.5,
+		},
+		{
+			name: "sizes topping limits by the area inside the rim",
+			candidates: []domain.PanCandidate{
+				{Pan: domain.Pan{Name: "medium", Shape: "round", Area: 700, RimWidth: 2}},
+			},
+			limitingIngredient: domain.Ingredient{Name: "mozzarella", Amount: 320},
+			wantSelections:     map[string]int{"medium": 3},
+			wantUsed:           315,
+			wantLeftover:       5,
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := balancer.ReverseBalance(context.Background(), recipe, tt.candidates, tt.limitingIngredient)
+
+			assert.NoError(t, err)
+
+			selections := make(map[string]int)
+			panCount := 0
+			for _, selection := range result.Selections {
+				selections[selection.Pan.Name] = selection.Quantity
+				panCount += selection.Quantity
+			}
+			assert.Equal(t, tt.wantSelections, selections)
+			assert.Len(t, result.RecipeAggregate.SplitIngredients.SplitDough, panCount)
+			assert.Equal(t, tt.wantUsed, result.UsedAmount)
+			assert.Equal(t, tt.wantLeftover, result.LeftoverAmount)
+			assert.GreaterOrEqual(t, result.LeftoverAmount, 0.0)
+		})
+	}
+}
+
+func TestReverseBalance_WithProcessLoss(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+			ProcessLoss: &domain.ProcessLoss{MixerPercentage: 5},
+		},
+	}
+	candidates := []domain.PanCandidate{{Pan: domain.Pan{Name: "teglia", Area: 500}}}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.ReverseBalance(context.Background(), recipe, candidates, domain.Ingredient{Name: "flour", Amount: 1000})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Selections, 1)
+	assert.Equal(t, 6, result.Selections[0].Quantity)
+	assert.Equal(t, 945.0, result.UsedAmount)
+	assert.Equal(t, 55.0, result.LeftoverAmount)
+	assert.Equal(t, result.UsedAmount, result.RecipeAggregate.DoughToMix.Ingredients[0].Amount)
+}
+
+func TestReverseBalance_WithUnusedToppingRecipe(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "mozzarella", Amount: 200}},
+		},
+		ToppingRecipes: []domain.Topping{{
+			Name:          "bufala",
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "Mozzarella", Amount: 250}},
+		}},
+	}
+	candidates := []domain.PanCandidate{{Pan: domain.Pan{Name: "medium", Area: 700}}}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.ReverseBalance(context.Background(), recipe, candidates, domain.Ingredient{Name: "mozzarella", Amount: 300})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Selections[0].Quantity)
+	assert.Equal(t, 280.0, result.UsedAmount)
+	assert.Equal(t, 20.0, result.LeftoverAmount)
+}
+
+func TestReverseBalance_Errors(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "egg", Amount: 1, Scaling: "per_pan"}},
+		},
+		ToppingRecipes: []domain.Topping{{
+			Name:          "diavola",
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "salame", Amount: 80}},
+		}},
+	}
+	candidates := []domain.PanCandidate{{Pan: domain.Pan{Name: "small", Area: 500, ToppingRecipe: "diavola"}}}
+
+	tests := []struct {
+		name               string
+		candidates         []domain.PanCandidate
+		limitingIngredient domain.Ingredient
+		wantErr            string
+	}{
+		{
+			name:               "invalid amount",
+			candidates:         candidates,
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 0},
+		},
+		{
+			name:               "ingredient not in recipe",
+			candidates:         candidates,
+			limitingIngredient: domain.Ingredient{Name: "semola", Amount: 1000},
+		},
+		{
+			name:               "not enough for any pan",
+			candidates:         candidates,
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 10},
+		},
+		{
+			name:               "ingredient not scaled by area",
+			candidates:         candidates,
+			limitingIngredient: domain.Ingredient{Name: "egg", Amount: 12},
+		},
+		{
+			name:               "ingredient in a per-pan topping recipe",
+			candidates:         candidates,
+			limitingIngredient: domain.Ingredient{Name: "Salame", Amount: 200},
+			wantErr:            "limiting ingredient in per-pan topping recipes is not supported: Salame",
+		},
+		{
+			name:               "ingredient in a section topping recipe",
+			candidates:         []domain.PanCandidate{{Pan: domain.Pan{Name: "mezza", Area: 500, Sections: []domain.PanSection{{Fraction: 1, ToppingRecipe: "Diavola"}}}}},
+			limitingIngredient: domain.Ingredient{Name: "salame", Amount: 200},
+			wantErr:            "limiting ingredient in per-pan topping recipes is not supported: salame",
+		},
+		{
+			name:               "invalid pan area",
+			candidates:         []domain.PanCandidate{{Pan: domain.Pan{Name: "broken"}}},
+			limitingIngredient: domain.Ingredient{Name: "flour", Amount: 1000},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := balancer.ReverseBalance(context.Background(), recipe, tt.candidates, tt.limitingIngredient)
+
+			assert.Error(t, err)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func intPointer(v int) *int {
+	return &v
+}
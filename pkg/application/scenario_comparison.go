@@ -0,0 +1,92 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func (bs IngredientsBalancerService) CompareScenarios(ctx context.Context, recipe domain.Recipe, scenarios []domain.PanScenario, servings int, prices []domain.IngredientPrice) ([]domain.ScenarioComparison, error) {
+	if len(scenarios) == 0 {
+		return nil, errors.New("no scenarios provided")
+	}
+	if servings < 0 {
+		return nil, errors.New("invalid servings target")
+	}
+
+	unitPrices := make(map[string]float64, len(prices))
+	for _, price := range prices {
+		if price.Price < 0 || price.Quantity <= 0 {
+			return nil, errors.New("invalid price for " + price.Name)
+		}
+		unitPrices[canonicalName(price.Name)] = price.Price / price.Quantity
+	}
+
+	comparisons := make([]domain.ScenarioComparison, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		comparison, err := bs.compareScenario(ctx, recipe, scenario, servings, unitPrices)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %s: %w", scenario.Name, err)
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, nil
+}
+
+func (bs IngredientsBalancerService) compareScenario(ctx context.Context, recipe domain.Recipe, scenario domain.PanScenario, servings int, unitPrices map[string]float64) (domain.ScenarioComparison, error) {
+	recipeAggregate, err := bs.Balance(ctx, recipe, scenario.Pans)
+	if err != nil {
+		return domain.ScenarioComparison{}, err
+	}
+
+	comparison := domain.ScenarioComparison{
+		Name:       scenario.Name,
+		TotalDough: round(sumIngredients(recipeAggregate.Dough.Ingredients)),
+	}
+	totalTopping := 0.0
+	for _, topping := range recipeToppings(recipeAggregate.Recipe) {
+		totalTopping += sumIngredients(topping.Ingredients)
+	}
+	comparison.TotalTopping = round(totalTopping)
+
+	if servings > 0 {
+		for _, pan := range scenario.Pans.Pans {
+			if pan.Slices <= 0 && pan.Slicing == nil {
+				return domain.ScenarioComparison{}, errors.New("no slices defined for " + pan.Name)
+			}
+		}
+		for _, panSlices := range recipeAggregate.SplitIngredients.SplitSlices {
+			comparison.Servings += panSlices.Count
+		}
+		if comparison.Servings < servings {
+			recipeAggregate.Warnings = append(recipeAggregate.Warnings, fmt.Sprintf("scenario serves %d of %d servings", comparison.Servings, servings))
+		}
+		usedServings := min(servings, comparison.Servings)
+		comparison.Leftover = round(comparison.TotalDough * float64(comparison.Servings-usedServings) / float64(comparison.Servings))
+		comparison.Utilization = round(float64(usedServings) / float64(comparison.Servings) * 100)
+	}
+
+	if len(unitPrices) > 0 {
+		shoppingList, err := bs.GenerateShoppingList(ctx, []domain.RecipeAggregate{*recipeAggregate}, nil, nil)
+		if err != nil {
+			return domain.ScenarioComparison{}, err
+		}
+		cost := 0.0
+		for _, item := range shoppingList.Items {
+			unitPrice, ok := unitPrices[item.Name]
+			if !ok {
+				comparison.UnpricedIngredients = append(comparison.UnpricedIngredients, item.Name)
+				continue
+			}
+			cost += item.RequiredAmount * unitPrice
+		}
+		cost = math.Round(cost*100) / 100
+		comparison.Cost = &cost
+	}
+
+	comparison.RecipeAggregate = *recipeAggregate
+	return comparison, nil
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestCompareScenarios(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+	}
+	twoLargePans := domain.PanScenario{
+		Name: "two large pans",
+		Pans: domain.Pans{Pans: []domain.Pan{
+			{Name: "teglia 1", Area: 1200, Slices: 12},
+			{Name: "teglia 2", Area: 1200, Slices: 12},
+		}},
+	}
+	fiveRounds := domain.PanScenario{Name: "five small rounds"}
+	for _, name := range []string{"tonda 1", "tonda 2", "tonda 3", "tonda 4", "tonda 5"} {
+		fiveRounds.Pans.Pans = append(fiveRounds.Pans.Pans, domain.Pan{
+			Name:    name,
+			Area:    500,
+			Slicing: &domain.SlicingPattern{Pattern: "radial", Slices: 6},
+		})
+	}
+	prices := []domain.IngredientPrice{
+		{Name: "Flour", Price: 1.2, Quantity: 1000},
+		{Name: "tomato", Price: 2, Quantity: 1000},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("compares scenarios side by side", func(t *testing.T) {
+		result, err := balancer.CompareScenarios(context.Background(), recipe, []domain.PanScenario{twoLargePans, fiveRounds}, 20, prices)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		tests := []struct {
+			name         string
+			totalDough   float64
+			totalTopping float64
+			servings     int
+			leftover     float64
+			utilization  float64
+			cost         float64
+		}{
+			{name: "two large pans", totalDough: 1200, totalTopping: 720, servings: 24, leftover: 200, utilization: 83.3, cost: 2.3},
+			{name: "five small rounds", totalDough: 1250, totalTopping: 750, servings: 30, leftover: 416.7, utilization: 66.7, cost: 2.4},
+		}
+		for i, tt := range tests {
+			assert.Equal(t, tt.name, result[i].Name)
+			assert.Equal(t, tt.totalDough, result[i].TotalDough)
+			assert.Equal(t, tt.totalTopping, result[i].TotalTopping)
+			assert.Equal(t, tt.servings, result[i].Servings)
+			assert.Equal(t, tt.leftover, result[i].Leftover)
+			assert.Equal(t, tt.utilization, result[i].Utilization)
+			assert.Equal(t, tt.cost, *result[i].Cost)
+			assert.Equal(t, []string{"water"}, result[i].UnpricedIngredients)
+			assert.Len(t, result[i].RecipeAggregate.SplitIngredients.SplitDough, len(twoLargePans.Pans.Pans)+3*i)
+		}
+	})
+
+	t.Run("without servings target or prices", func(t *testing.T) {
+		result, err := balancer.CompareScenarios(context.Background(), recipe, []domain.PanScenario{twoLargePans}, 0, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1200.0, result[0].TotalDough)
+		assert.Zero(t, result[0].Servings)
+		assert.Zero(t, result[0].Leftover)
+		assert.Zero(t, result[0].Utilization)
+		assert.Nil(t, result[0].Cost)
+	})
+
+	t.Run("scenario short of the servings target", func(t *testing.T) {
+		result, err := balancer.CompareScenarios(context.Background(), recipe, []domain.PanScenario{twoLargePans}, 30, nil)
+
+		assert.NoError(t, err)
+		assert.Zero(t, result[0].Leftover)
+		assert.Equal(t, 100.0, result[0].Utilization)
+		assert.Contains(t, result[0].RecipeAggregate.Warnings, "scenario serves 24 of 30 servings")
+	})
+
+	errorTests := []struct {
+		name      string
+		scenarios []domain.PanScenario
+		servings  int
+		prices    []domain.IngredientPrice
+		wantErr   string
+	}{
+		{name: "no scenarios", wantErr: "no scenarios provided"},
+		{name: "negative servings", scenarios: []domain.PanScenario{twoLargePans}, servings: -1, wantErr: "invalid servings target"},
+		{
+			name:      "invalid price",
+			scenarios: []domain.PanScenario{twoLargePans},
+			prices:    []domain.IngredientPrice{{Name: "flour", Price: 1.2}},
+			wantErr:   "invalid price for flour",
+		},
+		{
+			name:      "pans without slices",
+			scenarios: []domain.PanScenario{{Name: "plain", Pans: domain.Pans{Pans: []domain.Pan{{Name: "teglia", Area: 1200}}}}},
+			servings:  10,
+			wantErr:   "scenario plain: no slices defined for teglia",
+		},
+		{
+			name:      "scenario that cannot be balanced",
+			scenarios: []domain.PanScenario{twoLargePans, {Name: "empty"}},
+			wantErr:   "scenario empty: invalid dough weight",
+		},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := balancer.CompareScenarios(context.Background(), recipe, tt.scenarios, tt.servings, tt.prices)
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Nil(t, result)
+		})
+	}
+}
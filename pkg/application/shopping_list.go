@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const packageRoundingTolerance = 1e-9
+
+func (bs IngredientsBalancerService) GenerateShoppingList(ctx context.Context, recipeAggregates []domain.RecipeAggregate, packageSizes []domain.PackageSize, stock []domain.Ingredient) (*domain.ShoppingList, error) {
+	if len(recipeAggregates) == 0 {
+		return nil, errors.New("no balanced recipes provided")
+	}
+
+	sizes := make(map[string]float64, len(packageSizes))
+	for _, packageSize := range packageSizes {
+		if packageSize.Size <= 0 {
+			return nil, errors.New("invalid package size for " + packageSize.Name)
+		}
+		sizes[canonicalName(packageSize.Name)] = packageSize.Size
+	}
+
+	stockOnHand := make(map[string]float64, len(stock))
+	for _, ingredient := range stock {
+		stockOnHand[canonicalName(ingredient.Name)] += ingredient.Amount
+	}
+
+	required := make(map[string]float64)
+	for _, recipeAggregate := range recipeAggregates {
+		doughIngredients := recipeAggregate.Dough.Ingredients
+		if recipeAggregate.DoughToMix != nil {
+			doughIngredients = recipeAggregate.DoughToMix.Ingredients
+		}
+		for _, ingredient := range doughIngredients {
+			required[canonicalName(ingredient.Name)] += ingredient.Amount
+		}
+		for _, topping := range recipeToppings(recipeAggregate.Recipe) {
+			for _, ingredient := range topping.Ingredients {
+				required[canonicalName(ingredient.Name)] += ingredient.Amount
+			}
+		}
+		for _, ingredient := range recipeAggregate.PanPreparation {
+			required[canonicalName(ingredient.Name)] += ingredient.Amount
+		}
+	}
+
+	items := make([]domain.ShoppingItem, 0, len(required))
+	for name, requiredAmount := range required {
+		items = append(items, toShoppingItem(name, requiredAmount, stockOnHand[name], sizes[name]))
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return &domain.ShoppingList{Items: items}, nil
+}
+
+func toShoppingItem(name string, requiredAmount, stockAmount, packageSize float64) domain.ShoppingItem {
+	neededAmount := math.Max(round(requiredAmount-stockAmount), 0)
+	item := domain.ShoppingItem{
+		Name:           name,
+		RequiredAmount: round(requiredAmount),
+		StockAmount:    round(stockAmount),
+		NeededAmount:   neededAmount,
+		PackageSize:    packageSize,
+		PurchaseAmount: neededAmount,
+	}
+
+	if packageSize > 0 && neededAmount > 0 {
+		item.Packages = int(math.Ceil(neededAmount/packageSize - packageRoundingTolerance))
+		item.PurchaseAmount = float64(item.Packages) * packageSize
+	}
+
+	return item
+}
+
+func canonicalName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
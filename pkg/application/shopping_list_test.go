@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestGenerateShoppingList(t *testing.T) {
+	recipeAggregates := []domain.RecipeAggregate{
+		{
+			Recipe: domain.Recipe{
+				Dough: domain.Dough{
+					Ingredients: []domain.Ingredient{
+						{Name: "Flour", Amount: 20000},
+						{Name: "water", Amount: 14000},
+					},
+				},
+				Topping: domain.Topping{
+					Ingredients: []domain.Ingredient{
+						{Name: "Mozzarella", Amount: 1500},
+					},
+				},
+			},
+		},
+		{
+			Recipe: domain.Recipe{
+				Dough: domain.Dough{
+					Ingredients: []domain.Ingredient{
+						{Name: " flour ", Amount: 10000},
+					},
+				},
+				Topping: domain.Topping{
+					Ingredients: []domain.Ingredient{
+						{Name: "mozzarella", Amount: 800},
+					},
+				},
+			},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("sums by canonical name and rounds up to packages", func(t *testing.T) {
+		result, err := balancer.GenerateShoppingList(
+			context.Background(),
+			recipeAggregates,
+			[]domain.PackageSize{
+				{Name: "flour", Size: 25000},
+				{Name: "Mozzarella", Size: 1000},
+			},
+			nil,
+		)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 3)
+
+		flour := result.Items[0]
+		assert.Equal(t, "flour", flour.Name)
+		assert.Equal(t, 30000.0, flour.RequiredAmount)
+		assert.Equal(t, 2, flour.Packages)
+		assert.Equal(t, 50000.0, flour.PurchaseAmount)
+
+		mozzarella := result.Items[1]
+		assert.Equal(t, "mozzarella", mozzarella.Name)
+		assert.Equal(t, 2300.0, mozzarella.RequiredAmount)
+		assert.Equal(t, 3, mozzarella.Packages)
+		assert.Equal(t, 3000.0, mozzarella.PurchaseAmount)
+
+		water := result.Items[2]
+		assert.Equal(t, "water", water.Name)
+		assert.Equal(t, 0, water.Packages)
+		assert.Equal(t, 14000.0, water.PurchaseAmount)
+	})
+
+	t.Run("subtracts stock on hand", func(t *testing.T) {
+		result, err := balancer.GenerateShoppingList(
+			context.Background(),
+			recipeAggregates,
+			[]domain.PackageSize{{Name: "flour", Size: 25000}},
+			[]domain.Ingredient{
+				{Name: "FLOUR", Amount: 6000},
+				{Name: "water", Amount: 20000},
+			},
+		)
+
+		assert.NoError(t, err)
+
+		flour := result.Items[0]
+		assert.Equal(t, 6000.0, flour.StockAmount)
+		assert.Equal(t, 24000.0, flour.NeededAmount)
+		assert.Equal(t, 1, flour.Packages)
+		assert.Equal(t, 25000.0, flour.PurchaseAmount)
+
+		water := result.Items[2]
+		assert.Equal(t, 0.0, water.NeededAmount)
+		assert.Equal(t, 0.0, water.PurchaseAmount)
+	})
+
+	t.Run("includes topping layers", func(t *testing.T) {
+		layered := domain.RecipeAggregate{
+			Recipe: domain.Recipe{
+				ToppingLayers: []domain.Topping{
+					{Name: "cheese", Ingredients: []domain.Ingredient{{Name: "Mozzarella", Amount: 700}}},
+					{Name: "garnish", Ingredients: []domain.Ingredient{{Name: "basil", Amount: 20}}},
+				},
+			},
+		}
+
+		result, err := balancer.GenerateShoppingList(context.Background(), append(recipeAggregates, layered), nil, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 4)
+		assert.Equal(t, "basil", result.Items[0].Name)
+		assert.Equal(t, 3000.0, result.Items[2].RequiredAmount)
+	})
+
+	t.Run("no recipe aggregates", func(t *testing.T) {
+		result, err := balancer.GenerateShoppingList(context.Background(), nil, nil, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("invalid package size", func(t *testing.T) {
+		result, err := balancer.GenerateShoppingList(
+			context.Background(),
+			recipeAggregates,
+			[]domain.PackageSize{{Name: "flour", Size: 0}},
+			nil,
+		)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCanonicalName(t *testing.T) {
+	assert.Equal(t, "fior di latte", canonicalName("  Fior   di Latte "))
+	assert.Equal(t, "flour", canonicalName("FLOUR"))
+}
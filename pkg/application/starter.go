@@ -0,0 +1,83 @@
+package application
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	defaultStarterHydration   = 100
+	hydrationWarningTolerance = 0.5
+	starterFlourFormulaSuffix = " flour"
+	starterWaterFormulaSuffix = " water"
+)
+
+func hasStarter(ingredients []domain.Ingredient) bool {
+	for _, ingredient := range ingredients {
+		if ingredientKind(ingredient) == ingredientKindStarter {
+			return true
+		}
+	}
+	return false
+}
+
+func starterComposition(starter domain.Ingredient) (flour float64, water float64) {
+	hydration := starter.Hydration
+	if hydration <= 0 {
+		hydration = defaultStarterHydration
+	}
+	flour = starter.Amount / (1 + hydration/100)
+	return flour, starter.Amount - flour
+}
+
+func calculateFormula(ingredients []domain.Ingredient) ([]domain.Ingredient, float64) {
+	totalFlour := sumIngredientsOfKind(ingredients, ingredientKindFlour)
+	totalWater := sumIngredientsOfKind(ingredients, ingredientKindWater)
+	for _, ingredient := range ingredients {
+		if ingredientKind(ingredient) == ingredientKindStarter {
+			starterFlour, starterWater := starterComposition(ingredient)
+			totalFlour += starterFlour
+			totalWater += starterWater
+		}
+	}
+	if totalFlour <= 0 {
+		return nil, 0
+	}
+
+	var formula []domain.Ingredient
+	for _, ingredient := range ingredients {
+		if ingredientKind(ingredient) != ingredientKindStarter {
+			formula = append(formula, domain.Ingredient{
+				Name:   ingredient.Name,
+				Amount: round(ingredient.Amount / totalFlour * 100),
+				Type:   ingredient.Type,
+			})
+			continue
+		}
+
+		starterFlour, starterWater := starterComposition(ingredient)
+		formula = append(formula,
+			domain.Ingredient{
+				Name:   ingredient.Name + starterFlourFormulaSuffix,
+				Amount: round(starterFlour / totalFlour * 100),
+				Type:   ingredientKindFlour,
+			},
+			domain.Ingredient{
+				Name:   ingredient.Name + starterWaterFormulaSuffix,
+				Amount: round(starterWater / totalFlour * 100),
+				Type:   ingredientKindWater,
+			},
+		)
+	}
+
+	return formula, round(totalWater / totalFlour * 100)
+}
+
+func hydrationWarning(effectiveHydration float64, targetHydration float64) string {
+	if targetHydration <= 0 || math.Abs(effectiveHydration-targetHydration) <= hydrationWarningTolerance {
+		return ""
+	}
+	return fmt.Sprintf("effective hydration %g%% differs from target hydration %g%%", effectiveHydration, targetHydration)
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestCalculateFormula(t *testing.T) {
+	t.Run("starter flour and water count towards the formula", func(t *testing.T) {
+		ingredients := []domain.Ingredient{
+			{Name: "flour", Amount: 900},
+			{Name: "water", Amount: 600},
+			{Name: "mature starter", Amount: 200, Type: "starter", Hydration: 100},
+			{Name: "salt", Amount: 25},
+		}
+
+		formula, hydration := calculateFormula(ingredients)
+
+		assert.Equal(t, 70.0, hydration)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "flour", Amount: 90},
+			{Name: "water", Amount: 60},
+			{Name: "mature starter flour", Amount: 10, Type: "flour"},
+			{Name: "mature starter water", Amount: 10, Type: "water"},
+			{Name: "salt", Amount: 2.5},
+		}, formula)
+	})
+
+	t.Run("stiff starter", func(t *testing.T) {
+		ingredients := []domain.Ingredient{
+			{Name: "flour", Amount: 800},
+			{Name: "water", Amount: 500},
+			{Name: "Lievito madre", Amount: 300, Hydration: 50},
+		}
+
+		formula, hydration := calculateFormula(ingredients)
+
+		assert.Equal(t, 60.0, hydration)
+		assert.Equal(t, 20.0, formula[2].Amount)
+		assert.Equal(t, 10.0, formula[3].Amount)
+	})
+
+	t.Run("no flour", func(t *testing.T) {
+		formula, hydration := calculateFormula([]domain.Ingredient{{Name: "water", Amount: 100}})
+
+		assert.Nil(t, formula)
+		assert.Equal(t, 0.0, hydration)
+	})
+}
+
+func TestHydrationWarning(t *testing.T) {
+	assert.Empty(t, hydrationWarning(70, 0))
+	assert.Empty(t, hydrationWarning(70.5, 70))
+	assert.Empty(t, hydrationWarning(69.5, 70))
+	assert.Equal(t, "effective hydration 70.7% differs from target hydration 70%", hydrationWarning(70.7, 70))
+	assert.Equal(t, "effective hydration 69.3% differs from target hydration 70%", hydrationWarning(69.3, 70))
+	assert.Equal(t, "effective hydration 72.5% differs from target hydration 70%", hydrationWarning(72.5, 70))
+}
+
+func TestBalance_WithStarter(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 54},
+				{Name: "water", Amount: 34},
+				{Name: "levain", Amount: 10.5, Hydration: 100},
+				{Name: "salt", Amount: 1.5},
+			},
+			Targets: domain.DoughTargets{Hydration: 66},
+		},
+		Topping: domain.Topping{ReferenceArea: 1000},
+	}
+	pans := domain.Pans{
+		TotalArea: 2000,
+		Pans:      []domain.Pan{{Name: "teglia", Area: 2000}},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 105.0, result.Dough.Ingredients[2].Amount)
+	assert.Equal(t, 100.0, result.Dough.Ingredients[2].Hydration)
+	assert.Equal(t, 66.2, result.EffectiveHydration)
+	assert.Len(t, result.FormulaPercentages, 5)
+	assert.Empty(t, result.Warnings)
+
+	recipe.Dough.Targets.Hydration = 70
+	result, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Warnings, 1)
+
+	recipe.Dough.Targets.Hydration = 0
+	recipe.Dough.Ingredients[2] = domain.Ingredient{Name: "evoOil", Amount: 10.5}
+	result, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.FormulaPercentages)
+	assert.Equal(t, 0.0, result.EffectiveHydration)
+}
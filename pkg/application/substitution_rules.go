@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	ruleSectionDough         = "dough"
+	ruleSectionTopping       = "topping"
+	ruleSectionToppingRecipe = "topping_recipe"
+)
+
+func (bs IngredientsBalancerService) WithSubstitutionRules(catalog domain.SubstitutionCatalog) *IngredientsBalancerService {
+	bs.substitutionRules = catalog
+	return &bs
+}
+
+func (bs IngredientsBalancerService) ApplySubstitutionRules(ctx context.Context, recipe domain.Recipe, tenant string, ruleSets []string) (domain.Recipe, []domain.RuleApplication, error) {
+	tenantRuleSets, ok := bs.substitutionRules.Tenants[tenant]
+	if tenant != "" && !ok {
+		return recipe, nil, errors.New("unknown tenant: " + tenant)
+	}
+	selected := append(append([]string{}, tenantRuleSets...), ruleSets...)
+
+	doughTotal := sumIngredients(recipe.Dough.Ingredients)
+	doughIngredients := recipe.Dough.Ingredients
+	toppingIngredients := recipe.Topping.Ingredients
+	layers := make([]domain.Topping, len(recipe.ToppingLayers))
+	copy(layers, recipe.ToppingLayers)
+	toppingRecipes := make([]domain.Topping, len(recipe.ToppingRecipes))
+	copy(toppingRecipes, recipe.ToppingRecipes)
+
+	var applications []domain.RuleApplication
+	applied := make(map[string]bool, len(selected))
+	for _, ruleSet := range selected {
+		if applied[ruleSet] {
+			continue
+		}
+		applied[ruleSet] = true
+
+		rules, ok := bs.substitutionRules.RuleSets[ruleSet]
+		if !ok {
+			return recipe, nil, errors.New("unknown rule set: " + ruleSet)
+		}
+
+		for _, rule := range rules {
+			var fired []domain.RuleApplication
+			doughIngredients, fired = applySubstitutionRule(doughIngredients, rule)
+			applications = append(applications, ruleApplications(fired, ruleSet, ruleSectionDough)...)
+
+			toppingIngredients, fired = applySubstitutionRule(toppingIngredients, rule)
+			applications = append(applications, ruleApplications(fired, ruleSet, ruleSectionTopping)...)
+
+			for i := range layers {
+				layers[i].Ingredients, fired = applySubstitutionRule(layers[i].Ingredients, rule)
+				applications = append(applications, ruleApplications(fired, ruleSet, ruleSectionTopping+":"+layers[i].Name)...)
+			}
+
+			for i := range toppingRecipes {
+				toppingRecipes[i].Ingredients, fired = applySubstitutionRule(toppingRecipes[i].Ingredients, rule)
+				applications = append(applications, ruleApplications(fired, ruleSet, ruleSectionToppingRecipe+":"+toppingRecipes[i].Name)...)
+			}
+		}
+	}
+
+	if total := sumIngredients(doughIngredients); doughTotal > 0 && total > 0 && total != doughTotal {
+		for i := range doughIngredients {
+			doughIngredients[i].Amount *= doughTotal / total
+		}
+	}
+
+	recipe.Dough.Ingredients = doughIngredients
+	recipe.Topping.Ingredients = toppingIngredients
+	if len(layers) > 0 {
+		recipe.ToppingLayers = layers
+	}
+	if len(toppingRecipes) > 0 {
+		recipe.ToppingRecipes = toppingRecipes
+	}
+	return recipe, applications, nil
+}
+
+func applySubstitutionRule(ingredients []domain.Ingredient, rule domain.SubstitutionRule) ([]domain.Ingredient, []domain.RuleApplication) {
+	fraction := rule.Fraction
+	if fraction <= 0 {
+		fraction = 1
+	}
+	factor := rule.Factor
+	if factor <= 0 {
+		factor = 1
+	}
+
+	var (
+		result           []domain.Ingredient
+		applications     []domain.RuleApplication
+		matchedAmount    float64
+		replacementIndex = -1
+	)
+	for _, ingredient := range ingredients {
+		if ingredient.Amount <= 0 || !matchesRule(ingredient, rule) {
+			result = append(result, ingredient)
+			continue
+		}
+		matchedAmount += ingredient.Amount
+
+		if rule.Replacement == "" {
+			result = append(result, ingredient)
+			continue
+		}
+
+		replacedAmount := ingredient.Amount * fraction
+		if remaining := ingredient.Amount - replacedAmount; remaining > 0 {
+			keptIngredient := ingredient
+			keptIngredient.Amount = remaining
+			result = append(result, keptIngredient)
+		}
+		if replacementIndex < 0 {
+			replacementIndex = len(result)
+			replacement := domain.Ingredient{Name: rule.Replacement, Type: ingredient.Type}
+			if rule.Ingredient == "" {
+				replacement.Type = canonicalName(rule.Kind)
+			}
+			result = append(result, replacement)
+		}
+		result[replacementIndex].Amount += replacedAmount * factor
+
+		applications = append(applications, domain.RuleApplication{
+			Rule:              rule.Name,
+			Ingredient:        ingredient.Name,
+			Replacement:       rule.Replacement,
+			ReplacedAmount:    round(replacedAmount),
+			ReplacementAmount: round(replacedAmount * factor),
+		})
+	}
+
+	if matchedAmount <= 0 {
+		return result, applications
+	}
+	for _, addition := range rule.Additions {
+		additionAmount := matchedAmount * addition.Amount / 100
+		result = addIngredientAmount(result, addition.Name, additionAmount)
+		applications = append(applications, domain.RuleApplication{
+			Rule:              rule.Name,
+			Replacement:       addition.Name,
+			ReplacementAmount: round(additionAmount),
+		})
+	}
+
+	return result, applications
+}
+
+func matchesRule(ingredient domain.Ingredient, rule domain.SubstitutionRule) bool {
+	if rule.Ingredient != "" {
+		return canonicalName(ingredient.Name) == canonicalName(rule.Ingredient)
+	}
+	return ingredientKind(ingredient) == canonicalName(rule.Kind)
+}
+
+func addIngredientAmount(ingredients []domain.Ingredient, name string, amount float64) []domain.Ingredient {
+	for i, ingredient := range ingredients {
+		if canonicalName(ingredient.Name) == canonicalName(name) {
+			ingredients[i].Amount += amount
+			return ingredients
+		}
+	}
+	return append(ingredients, domain.Ingredient{Name: name, Amount: amount})
+}
+
+func ruleApplications(applications []domain.RuleApplication, ruleSet string, section string) []domain.RuleApplication {
+	for i := range applications {
+		applications[i].RuleSet = ruleSet
+		applications[i].Section = section
+	}
+	return applications
+}
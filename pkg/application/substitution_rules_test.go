@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestApplySubstitutionRules(t *testing.T) {
+	catalog := domain.SubstitutionCatalog{
+		RuleSets: map[string][]domain.SubstitutionRule{
+			"vegan": {
+				{Name: "vegan mozzarella", Ingredient: "Fior di latte", Replacement: "vegan mozzarella", Factor: 0.9},
+			},
+			"whole-wheat": {
+				{
+					Name:        "whole wheat 20%",
+					Kind:        "flour",
+					Replacement: "whole wheat flour",
+					Fraction:    0.2,
+					Additions:   []domain.Ingredient{{Name: "water", Amount: 5}},
+				},
+			},
+		},
+		Tenants: map[string][]string{
+			"pizzeria-roma": {"vegan"},
+		},
+	}
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 38},
+				{Name: "salt", Amount: 2},
+			},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients: []domain.Ingredient{
+				{Name: "tomato", Amount: 300},
+				{Name: "fior di latte", Amount: 200},
+			},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{}).WithSubstitutionRules(catalog)
+
+	t.Run("tenant and request rule sets", func(t *testing.T) {
+		result, applications, err := balancer.ApplySubstitutionRules(context.Background(), recipe, "pizzeria-roma", []string{"whole-wheat", "vegan"})
+
+		assert.NoError(t, err)
+		expectedDough := []domain.Ingredient{
+			{Name: "flour", Amount: 48},
+			{Name: "whole wheat flour", Amount: 12},
+			{Name: "water", Amount: 41},
+			{Name: "salt", Amount: 2},
+		}
+		assert.Len(t, result.Dough.Ingredients, len(expectedDough))
+		for i, ingredient := range expectedDough {
+			assert.Equal(t, ingredient.Name, result.Dough.Ingredients[i].Name)
+			assert.InDelta(t, ingredient.Amount*100/103, result.Dough.Ingredients[i].Amount, 1e-9)
+		}
+		assert.Equal(t, "flour", result.Dough.Ingredients[1].Type)
+		assert.Equal(t, ingredientKindFlour, ingredientKind(result.Dough.Ingredients[1]))
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "tomato", Amount: 300},
+			{Name: "vegan mozzarella", Amount: 180},
+		}, result.Topping.Ingredients)
+		assert.Equal(t, []domain.RuleApplication{
+			{RuleSet: "vegan", Rule: "vegan mozzarella", Section: "topping", Ingredient: "fior di latte", Replacement: "vegan mozzarella", ReplacedAmount: 200, ReplacementAmount: 180},
+			{RuleSet: "whole-wheat", Rule: "whole wheat 20%", Section: "dough", Ingredient: "flour", Replacement: "whole wheat flour", ReplacedAmount: 12, ReplacementAmount: 12},
+			{RuleSet: "whole-wheat", Rule: "whole wheat 20%", Section: "dough", Replacement: "water", ReplacementAmount: 3},
+		}, applications)
+
+		assert.Equal(t, 60.0, recipe.Dough.Ingredients[0].Amount)
+		assert.Equal(t, "fior di latte", recipe.Topping.Ingredients[1].Name)
+	})
+
+	t.Run("no rule sets", func(t *testing.T) {
+		result, applications, err := balancer.ApplySubstitutionRules(context.Background(), recipe, "", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, recipe, result)
+		assert.Empty(t, applications)
+	})
+
+	t.Run("unknown rule set", func(t *testing.T) {
+		_, _, err := balancer.ApplySubstitutionRules(context.Background(), recipe, "", []string{"gluten-free"})
+
+		assert.EqualError(t, err, "unknown rule set: gluten-free")
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, _, err := balancer.ApplySubstitutionRules(context.Background(), recipe, "pizzeria-milano", nil)
+
+		assert.EqualError(t, err, "unknown tenant: pizzeria-milano")
+	})
+}
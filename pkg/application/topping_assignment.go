@@ -0,0 +1,101 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func balanceRecipeTopping(recipe domain.Recipe, totalArea float64, portions []toppingPortion) (domain.Topping, []domain.Topping, []domain.ToppingGroup, error) {
+	if !hasToppingAssignments(portions) {
+		balancedTopping, splitToppings, err := balanceToppingPortions(recipe.Topping, totalArea, portions)
+		return balancedTopping, splitToppings, nil, err
+	}
+	return balanceToppingAssignments(recipe, portions)
+}
+
+func hasToppingAssignments(portions []toppingPortion) bool {
+	for _, portion := range portions {
+		if portion.recipe != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func findToppingRecipe(recipe domain.Recipe, name string) (domain.Topping, error) {
+	if name == "" || canonicalName(name) == canonicalName(recipe.Topping.Name) {
+		return recipe.Topping, nil
+	}
+	for _, topping := range recipe.ToppingRecipes {
+		if canonicalName(topping.Name) == canonicalName(name) {
+			return topping, nil
+		}
+	}
+	return domain.Topping{}, errors.New("unknown topping recipe: " + name)
+}
+
+func balanceToppingAssignments(recipe domain.Recipe, portions []toppingPortion) (domain.Topping, []domain.Topping, []domain.ToppingGroup, error) {
+	var (
+		groups       []domain.ToppingGroup
+		groupIndexes [][]int
+	)
+	positions := make(map[string]int)
+	for i, portion := range portions {
+		topping, err := findToppingRecipe(recipe, portion.recipe)
+		if err != nil {
+			return domain.Topping{}, nil, nil, err
+		}
+		key := canonicalName(topping.Name)
+		position, ok := positions[key]
+		if !ok {
+			position = len(groups)
+			positions[key] = position
+			groups = append(groups, domain.ToppingGroup{Name: topping.Name, Topping: topping})
+			groupIndexes = append(groupIndexes, nil)
+		}
+		groups[position].Pans = append(groups[position].Pans, portion.name)
+		groups[position].Area += portion.area
+		groupIndexes[position] = append(groupIndexes[position], i)
+	}
+
+	splitToppings := make([]domain.Topping, len(portions))
+	var consolidated []domain.Ingredient
+	for g := range groups {
+		groupPortions := make([]toppingPortion, len(groupIndexes[g]))
+		for j, i := range groupIndexes[g] {
+			groupPortions[j] = portions[i]
+		}
+
+		balancedTopping, groupSplit, err := balanceToppingPortions(groups[g].Topping, groups[g].Area, groupPortions)
+		if err != nil {
+			return domain.Topping{}, nil, nil, fmt.Errorf("topping recipe %s: %w", groups[g].Name, err)
+		}
+		balancedTopping.Name = groups[g].Name
+		groups[g].Topping = balancedTopping
+		for j, i := range groupIndexes[g] {
+			splitToppings[i] = groupSplit[j]
+		}
+		consolidated = mergeIngredients(consolidated, balancedTopping.Ingredients)
+	}
+
+	return domain.Topping{Ingredients: consolidated}, splitToppings, groups, nil
+}
+
+func mergeIngredients(merged []domain.Ingredient, ingredients []domain.Ingredient) []domain.Ingredient {
+	for _, ingredient := range ingredients {
+		found := false
+		for i := range merged {
+			if canonicalName(merged[i].Name) == canonicalName(ingredient.Name) {
+				merged[i].Amount = round(merged[i].Amount + ingredient.Amount)
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, ingredient)
+		}
+	}
+	return merged
+}
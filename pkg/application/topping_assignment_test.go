@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithToppingRecipes(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			Name:          "margherita",
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}, {Name: "mozzarella", Amount: 200}},
+		},
+		ToppingRecipes: []domain.Topping{
+			{
+				Name:          "diavola",
+				ReferenceArea: 1000,
+				Ingredients:   []domain.Ingredient{{Name: "Tomato", Amount: 300}, {Name: "salame", Amount: 100}},
+			},
+		},
+	}
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	t.Run("balances each topping over its own pans", func(t *testing.T) {
+		pans := domain.Pans{
+			TotalArea: 3000,
+			Pans: []domain.Pan{
+				{Name: "pan 1", Area: 500},
+				{Name: "pan 2", Area: 500, ToppingRecipe: "diavola"},
+				{Name: "pan 3", Area: 500, ToppingRecipe: "Margherita"},
+				{Name: "pan 4", Area: 500},
+				{Name: "pan 5", Area: 500, ToppingRecipe: "diavola"},
+				{Name: "pan 6", Area: 500, ToppingRecipe: "margherita"},
+			},
+		}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1500.0, sumIngredients(result.Dough.Ingredients))
+		assert.Equal(t, []domain.ToppingGroup{
+			{
+				Name: "margherita",
+				Pans: []string{"pan 1", "pan 3", "pan 4", "pan 6"},
+				Area: 2000,
+				Topping: domain.Topping{
+					Name:          "margherita",
+					ReferenceArea: 1000,
+					Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 600}, {Name: "mozzarella", Amount: 400}},
+				},
+			},
+			{
+				Name: "diavola",
+				Pans: []string{"pan 2", "pan 5"},
+				Area: 1000,
+				Topping: domain.Topping{
+					Name:          "diavola",
+					ReferenceArea: 1000,
+					Ingredients:   []domain.Ingredient{{Name: "Tomato", Amount: 300}, {Name: "salame", Amount: 100}},
+				},
+			},
+		}, result.ToppingGroups)
+		assert.Equal(t, []domain.Ingredient{
+			{Name: "tomato", Amount: 900},
+			{Name: "mozzarella", Amount: 400},
+			{Name: "salame", Amount: 100},
+		}, result.Topping.Ingredients)
+
+		splitToppings := result.SplitIngredients.SplitTopping
+		assert.Len(t, splitToppings, 6)
+		assert.Equal(t, "pan 2", splitToppings[1].Name)
+		assert.Equal(t, []domain.Ingredient{{Name: "Tomato", Amount: 150}, {Name: "salame", Amount: 50}}, splitToppings[1].Ingredients)
+		assert.Equal(t, []domain.Ingredient{{Name: "tomato", Amount: 150}, {Name: "mozzarella", Amount: 100}}, splitToppings[3].Ingredients)
+	})
+
+	t.Run("dough ball groups name their topping recipe", func(t *testing.T) {
+		groups := []domain.DoughBallGroup{
+			{Name: "margherita", Count: 2, BallWeight: 250},
+			{Name: "diavola", Count: 1, BallWeight: 250, ToppingRecipe: "diavola"},
+		}
+
+		result, err := balancer.BalanceDoughBalls(context.Background(), recipe, groups)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.ToppingGroups, 2)
+		assert.Equal(t, []string{"diavola"}, result.ToppingGroups[1].Pans)
+		assert.Equal(t, 500.0, result.ToppingGroups[1].Area)
+		assert.Equal(t, 50.0, result.ToppingGroups[1].Topping.Ingredients[1].Amount)
+	})
+
+	t.Run("without assignments uses the recipe topping", func(t *testing.T) {
+		pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "pan 1", Area: 1000}}}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.ToppingGroups)
+		assert.Equal(t, 300.0, result.Topping.Ingredients[0].Amount)
+	})
+
+	t.Run("unknown topping recipe", func(t *testing.T) {
+		pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "pan 1", Area: 1000, ToppingRecipe: "capricciosa"}}}
+
+		result, err := balancer.Balance(context.Background(), recipe, pans)
+
+		assert.EqualError(t, err, "unknown topping recipe: capricciosa")
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	bakingStageBeforeBake = "before_bake"
+	bakingStageMidBake    = "mid_bake"
+	bakingStageAfterBake  = "after_bake"
+)
+
+var bakingStages = []string{bakingStageBeforeBake, bakingStageMidBake, bakingStageAfterBake}
+
+func bakingStage(layer domain.Topping) (string, error) {
+	if layer.Stage == "" {
+		return bakingStageBeforeBake, nil
+	}
+	stage := canonicalName(layer.Stage)
+	for _, bakingStage := range bakingStages {
+		if stage == bakingStage {
+			return stage, nil
+		}
+	}
+	return "", errors.New("unknown baking stage for topping layer " + layer.Name + ": " + layer.Stage)
+}
+
+func balanceToppingLayers(layers []domain.Topping, totalArea float64, portions []toppingPortion) ([]domain.Topping, []domain.PanToppingLayers, error) {
+	if len(layers) == 0 {
+		return nil, nil, nil
+	}
+
+	splitLayers := make([]domain.PanToppingLayers, len(portions))
+	for i, portion := range portions {
+		splitLayers[i].Name = portion.name
+	}
+
+	balancedLayers := make([]domain.Topping, 0, len(layers))
+	for _, layer := range layers {
+		stage, err := bakingStage(layer)
+		if err != nil {
+			return nil, nil, err
+		}
+		layer.Stage = stage
+
+		balancedLayer, splitLayer, err := balanceToppingPortions(layer, totalArea, portions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("topping layer %s: %w", layer.Name, err)
+		}
+		balancedLayer.Name = layer.Name
+		balancedLayers = append(balancedLayers, balancedLayer)
+
+		for i := range splitLayer {
+			splitLayer[i].Name = layer.Name
+			splitLayers[i].Layers = append(splitLayers[i].Layers, splitLayer[i])
+		}
+	}
+
+	return balancedLayers, splitLayers, nil
+}
+
+func recipeToppings(recipe domain.Recipe) []domain.Topping {
+	return append([]domain.Topping{recipe.Topping}, recipe.ToppingLayers...)
+}
@@ -0,0 +1,120 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_WithToppingLayers(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		ToppingLayers: []domain.Topping{
+			{
+				Name:          "sauce",
+				ReferenceArea: 1000,
+				Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+			},
+			{
+				Name:          "cheese",
+				ReferenceArea: 500,
+				Stage:         "Mid_Bake",
+				Ingredients:   []domain.Ingredient{{Name: "mozzarella", Amount: 100}},
+			},
+			{
+				Name:    "garnish",
+				Stage:   "after_bake",
+				Scaling: "per_slice",
+				Ingredients: []domain.Ingredient{
+					{Name: "basil leaf", Amount: 1},
+					{Name: "evoOil", Amount: 10, Scaling: "fixed"},
+				},
+			},
+		},
+	}
+	pans := domain.Pans{
+		TotalArea: 1000,
+		Pans: []domain.Pan{
+			{Name: "teglia", Area: 600, Slices: 8},
+			{Name: "tonda", Area: 400, Slices: 6},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.ToppingLayers, 3)
+	assert.Equal(t, domain.Topping{
+		Name:          "sauce",
+		ReferenceArea: 1000,
+		Stage:         "before_bake",
+		Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+	}, result.ToppingLayers[0])
+	assert.Equal(t, "mid_bake", result.ToppingLayers[1].Stage)
+	assert.Equal(t, 200.0, result.ToppingLayers[1].Ingredients[0].Amount)
+	assert.Equal(t, 14.0, result.ToppingLayers[2].Ingredients[0].Amount)
+	assert.Equal(t, 10.0, result.ToppingLayers[2].Ingredients[1].Amount)
+
+	splitLayers := result.SplitIngredients.SplitToppingLayers
+	assert.Len(t, splitLayers, 2)
+	assert.Equal(t, "teglia", splitLayers[0].Name)
+	assert.Equal(t, "tonda", splitLayers[1].Name)
+	assert.Equal(t, []float64{180, 120, 8, 6}, []float64{
+		splitLayers[0].Layers[0].Ingredients[0].Amount,
+		splitLayers[0].Layers[1].Ingredients[0].Amount,
+		splitLayers[0].Layers[2].Ingredients[0].Amount,
+		splitLayers[0].Layers[2].Ingredients[1].Amount,
+	})
+	assert.Equal(t, []float64{120, 80, 6, 4}, []float64{
+		splitLayers[1].Layers[0].Ingredients[0].Amount,
+		splitLayers[1].Layers[1].Ingredients[0].Amount,
+		splitLayers[1].Layers[2].Ingredients[0].Amount,
+		splitLayers[1].Layers[2].Ingredients[1].Amount,
+	})
+	assert.Equal(t, "cheese", splitLayers[1].Layers[1].Name)
+	assert.Equal(t, "mid_bake", splitLayers[1].Layers[1].Stage)
+}
+
+func TestBalance_ToppingLayerErrors(t *testing.T) {
+	pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "teglia", Area: 1000}}}
+	recipe := domain.Recipe{
+		Dough: domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}}},
+		ToppingLayers: []domain.Topping{
+			{Name: "garnish", Stage: "before serving", Ingredients: []domain.Ingredient{{Name: "basil", Amount: 5}}},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	_, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.EqualError(t, err, "unknown baking stage for topping layer garnish: before serving")
+
+	recipe.ToppingLayers[0].Stage = ""
+	_, err = balancer.Balance(context.Background(), recipe, pans)
+
+	assert.EqualError(t, err, "topping layer garnish: invalid topping reference area")
+}
+
+func TestIngredientAmountPerArea_AcrossToppingLayers(t *testing.T) {
+	recipe := domain.Recipe{
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients:   []domain.Ingredient{{Name: "tomato", Amount: 300}},
+		},
+		ToppingLayers: []domain.Topping{
+			{ReferenceArea: 500, Ingredients: []domain.Ingredient{{Name: "Tomato", Amount: 100}}},
+		},
+	}
+
+	amountPerArea, inDough, err := ingredientAmountPerArea(recipe, nil, "tomato")
+
+	assert.NoError(t, err)
+	assert.False(t, inDough)
+	assert.InDelta(t, 0.5, amountPerArea, 1e-9)
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	toppingScalingArea     = "area"
+	toppingScalingPerPan   = "per_pan"
+	toppingScalingPerSlice = "per_slice"
+	toppingScalingFixed    = "fixed"
+	countableTolerance     = 1e-9
+)
+
+type toppingPortion struct {
+	name   string
+	area   float64
+	count  int
+	share  float64
+	slices int
+	recipe string
+}
+
+func panToppingPortions(pans domain.Pans) ([]toppingPortion, error) {
+	portions := make([]toppingPortion, 0, len(pans.Pans))
+	for _, pan := range pans.Pans {
+		area, err := innerPanArea(pan, panRimWidth(pans, pan))
+		if err != nil {
+			return nil, err
+		}
+		if len(pan.Sections) > 0 {
+			sections, err := panSectionPortions(pan, area)
+			if err != nil {
+				return nil, err
+			}
+			portions = append(portions, sections...)
+			continue
+		}
+		portions = append(portions, toppingPortion{
+			name:   pan.Name,
+			area:   area,
+			count:  1,
+			share:  1,
+			slices: pan.Slices,
+			recipe: pan.ToppingRecipe,
+		})
+	}
+	return portions, nil
+}
+
+func toppingScalingMode(ingredient domain.Ingredient) string {
+	if ingredient.Scaling == "" {
+		return toppingScalingArea
+	}
+	return canonicalName(ingredient.Scaling)
+}
+
+func ingredientScaling(topping domain.Topping, ingredient domain.Ingredient) string {
+	if ingredient.Scaling == "" && topping.Scaling != "" {
+		return canonicalName(topping.Scaling)
+	}
+	return toppingScalingMode(ingredient)
+}
+
+func balanceToppingPortions(topping domain.Topping, totalArea float64, portions []toppingPortion) (domain.Topping, []domain.Topping, error) {
+	areas := make([]float64, len(portions))
+	splitToppings := make([]domain.Topping, len(portions))
+	for i, portion := range portions {
+		areas[i] = portion.area
+		splitToppings[i] = domain.Topping{
+			Name:          portion.name,
+			ReferenceArea: topping.ReferenceArea,
+			Ingredients:   make([]domain.Ingredient, 0, len(topping.Ingredients)),
+			Stage:         topping.Stage,
+			Scaling:       topping.Scaling,
+		}
+	}
+
+	balancedTopping := domain.Topping{
+		ReferenceArea: topping.ReferenceArea,
+		Ingredients:   make([]domain.Ingredient, 0, len(topping.Ingredients)),
+		Stage:         topping.Stage,
+		Scaling:       topping.Scaling,
+	}
+	for _, ingredient := range topping.Ingredients {
+		var (
+			total float64
+			parts []float64
+		)
+		mode := ingredientScaling(topping, ingredient)
+		switch mode {
+		case toppingScalingArea:
+			if topping.ReferenceArea <= 0 {
+				return domain.Topping{}, nil, errors.New("invalid topping reference area")
+			}
+			total = round(ingredient.Amount * totalArea / topping.ReferenceArea)
+			parts = distributeExact(total, areas)
+		case toppingScalingFixed:
+			total = round(ingredient.Amount)
+			parts = distributeExact(total, areas)
+		case toppingScalingPerPan, toppingScalingPerSlice:
+			parts = make([]float64, len(portions))
+			for i, portion := range portions {
+				perPortion := ingredient.Amount * portion.share
+				if mode == toppingScalingPerSlice {
+					if portion.slices <= 0 {
+						return domain.Topping{}, nil, errors.New("no slices defined for " + portion.name)
+					}
+					perPortion *= float64(portion.slices)
+				}
+				parts[i] = math.Ceil(perPortion-countableTolerance) * float64(portion.count)
+				total += parts[i]
+			}
+		default:
+			return domain.Topping{}, nil, errors.New("unknown topping scaling mode: " + mode)
+		}
+
+		balancedIngredient := ingredient
+		balancedIngredient.Amount = total
+		balancedTopping.Ingredients = append(balancedTopping.Ingredients, balancedIngredient)
+		for i := range splitToppings {
+			splitIngredient := ingredient
+			splitIngredient.Amount = parts[i]
+			splitToppings[i].Ingredients = append(splitToppings[i].Ingredients, splitIngredient)
+		}
+	}
+
+	return balancedTopping, splitToppings, nil
+}
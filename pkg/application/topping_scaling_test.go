@@ -0,0 +1,131 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestBalance_ToppingScalingModes(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}},
+		},
+		Topping: domain.Topping{
+			ReferenceArea: 1000,
+			Ingredients: []domain.Ingredient{
+				{Name: "tomato", Amount: 300},
+				{Name: "egg", Amount: 1, Scaling: "per_pan"},
+				{Name: "basil leaf", Amount: 1, Scaling: "per_slice"},
+				{Name: "marinade", Amount: 50, Scaling: "fixed"},
+				{Name: "anchovy", Amount: 0.5, Scaling: "Per_Slice"},
+			},
+		},
+	}
+	pans := domain.Pans{
+		TotalArea: 1000,
+		Pans: []domain.Pan{
+			{Name: "teglia", Area: 600, Slices: 8},
+			{Name: "tonda", Area: 400, Slices: 5},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.Balance(context.Background(), recipe, pans)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Ingredient{
+		{Name: "tomato", Amount: 300},
+		{Name: "egg", Amount: 2, Scaling: "per_pan"},
+		{Name: "basil leaf", Amount: 13, Scaling: "per_slice"},
+		{Name: "marinade", Amount: 50, Scaling: "fixed"},
+		{Name: "anchovy", Amount: 7, Scaling: "Per_Slice"},
+	}, result.Topping.Ingredients)
+
+	assert.Len(t, result.SplitIngredients.SplitTopping, 2)
+	assert.Equal(t, "teglia", result.SplitIngredients.SplitTopping[0].Name)
+	assert.Equal(t, []domain.Ingredient{
+		{Name: "tomato", Amount: 180},
+		{Name: "egg", Amount: 1, Scaling: "per_pan"},
+		{Name: "basil leaf", Amount: 8, Scaling: "per_slice"},
+		{Name: "marinade", Amount: 30, Scaling: "fixed"},
+		{Name: "anchovy", Amount: 4, Scaling: "Per_Slice"},
+	}, result.SplitIngredients.SplitTopping[0].Ingredients)
+	assert.Equal(t, []domain.Ingredient{
+		{Name: "tomato", Amount: 120},
+		{Name: "egg", Amount: 1, Scaling: "per_pan"},
+		{Name: "basil leaf", Amount: 5, Scaling: "per_slice"},
+		{Name: "marinade", Amount: 20, Scaling: "fixed"},
+		{Name: "anchovy", Amount: 3, Scaling: "Per_Slice"},
+	}, result.SplitIngredients.SplitTopping[1].Ingredients)
+}
+
+func TestBalance_ToppingScalingErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		topping domain.Topping
+		err     string
+	}{
+		{
+			name:    "per slice without slices",
+			topping: domain.Topping{ReferenceArea: 1000, Ingredients: []domain.Ingredient{{Name: "basil", Amount: 1, Scaling: "per_slice"}}},
+			err:     "no slices defined for teglia",
+		},
+		{
+			name:    "unknown mode",
+			topping: domain.Topping{ReferenceArea: 1000, Ingredients: []domain.Ingredient{{Name: "basil", Amount: 1, Scaling: "per_guest"}}},
+			err:     "unknown topping scaling mode: per_guest",
+		},
+		{
+			name:    "area without reference area",
+			topping: domain.Topping{Ingredients: []domain.Ingredient{{Name: "tomato", Amount: 300}}},
+			err:     "invalid topping reference area",
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	pans := domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "teglia", Area: 1000}}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := domain.Recipe{
+				Dough:   domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}}},
+				Topping: tt.topping,
+			}
+
+			result, err := balancer.Balance(context.Background(), recipe, pans)
+
+			assert.Nil(t, result)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestBalanceDoughBalls_ToppingScalingModes(t *testing.T) {
+	recipe := domain.Recipe{
+		Dough: domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 60}, {Name: "water", Amount: 40}}},
+		Topping: domain.Topping{
+			Ingredients: []domain.Ingredient{{Name: "egg", Amount: 1, Scaling: "per_pan"}},
+		},
+	}
+	groups := []domain.DoughBallGroup{
+		{Name: "margherita", Count: 4, BallWeight: 250},
+		{Name: "baby", Count: 2, BallWeight: 150},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+	result, err := balancer.BalanceDoughBalls(context.Background(), recipe, groups)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6.0, result.Topping.Ingredients[0].Amount)
+	assert.Equal(t, 4.0, result.SplitIngredients.SplitTopping[0].Ingredients[0].Amount)
+	assert.Equal(t, 2.0, result.SplitIngredients.SplitTopping[1].Ingredients[0].Amount)
+
+	recipe.Topping.Ingredients[0].Scaling = "per_slice"
+	_, err = balancer.BalanceDoughBalls(context.Background(), recipe, groups)
+
+	assert.EqualError(t, err, "no slices defined for margherita")
+}
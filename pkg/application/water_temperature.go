@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	defaultTapWaterTemperature = 15
+	iceMeltingHeatFactor       = 80
+	doughTemperatureFactors    = 3
+)
+
+func (bs IngredientsBalancerService) CalculateWaterTemperature(ctx context.Context, recipeAggregate domain.RecipeAggregate, readings domain.DoughTemperatureReadings) (*domain.WaterTemperature, error) {
+	if readings.DesiredDoughTemperature <= 0 {
+		return nil, errors.New("invalid desired dough temperature")
+	}
+
+	doughIngredients := recipeAggregate.Dough.Ingredients
+	mixRatio := 1.0
+	if recipeAggregate.DoughToMix != nil {
+		doughIngredients = recipeAggregate.DoughToMix.Ingredients
+		mixRatio = sumIngredients(recipeAggregate.DoughToMix.Ingredients) / sumIngredients(recipeAggregate.Dough.Ingredients)
+	}
+	temperatureFactors := doughTemperatureFactors
+	knownTemperatures := readings.FlourTemperature + readings.RoomTemperature + readings.FrictionFactor
+	if recipeAggregate.PrefermentSplit != nil {
+		prefermentTemperature := readings.PrefermentTemperature
+		if prefermentTemperature == 0 {
+			prefermentTemperature = readings.RoomTemperature
+		}
+		doughIngredients = balanceIngredients(recipeAggregate.PrefermentSplit.FinalDough.Ingredients, mixRatio)
+		temperatureFactors++
+		knownTemperatures += prefermentTemperature
+	}
+
+	waterAmount := sumIngredientsOfKind(doughIngredients, ingredientKindWater)
+	if waterAmount <= 0 {
+		return nil, errors.New("dough has no water to temper")
+	}
+
+	waterTemperature := readings.DesiredDoughTemperature*float64(temperatureFactors) - knownTemperatures
+	tapWaterTemperature := readings.TapWaterTemperature
+	if tapWaterTemperature == 0 {
+		tapWaterTemperature = defaultTapWaterTemperature
+	}
+
+	result := &domain.WaterTemperature{
+		Temperature: round(waterTemperature),
+		WaterAmount: round(waterAmount),
+	}
+	if waterTemperature < tapWaterTemperature {
+		iceAmount := waterAmount * (tapWaterTemperature - waterTemperature) / (tapWaterTemperature + iceMeltingHeatFactor)
+		if iceAmount > waterAmount {
+			iceAmount = waterAmount
+		}
+		result.IceNeeded = true
+		result.IceAmount = round(iceAmount)
+		result.WaterAmount = round(waterAmount - iceAmount)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestCalculateWaterTemperature(t *testing.T) {
+	dough := domain.Dough{
+		Ingredients: []domain.Ingredient{
+			{Name: "flour", Amount: 1000},
+			{Name: "water", Amount: 650},
+			{Name: "salt", Amount: 25},
+		},
+	}
+	prefermentSplit := &domain.PrefermentSplit{
+		FinalDough: domain.Dough{
+			Ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 700},
+				{Name: "water", Amount: 300},
+				{Name: "poolish", Amount: 700},
+			},
+		},
+	}
+
+	doughToMix := &domain.Dough{
+		Ingredients: []domain.Ingredient{
+			{Name: "flour", Amount: 1100},
+			{Name: "water", Amount: 715},
+			{Name: "salt", Amount: 27.5},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		prefermentSplit *domain.PrefermentSplit
+		doughToMix      *domain.Dough
+		readings        domain.DoughTemperatureReadings
+		want            *domain.WaterTemperature
+	}{
+		{
+			name:     "straight dough",
+			readings: domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 20, RoomTemperature: 22, FrictionFactor: 12},
+			want:     &domain.WaterTemperature{Temperature: 18, WaterAmount: 650},
+		},
+		{
+			name:     "warm kitchen needs ice",
+			readings: domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 26, RoomTemperature: 28, FrictionFactor: 14},
+			want:     &domain.WaterTemperature{Temperature: 4, IceNeeded: true, IceAmount: 75.3, WaterAmount: 574.7},
+		},
+		{
+			name:     "all water replaced with ice",
+			readings: domain.DoughTemperatureReadings{DesiredDoughTemperature: 20, FlourTemperature: 30, RoomTemperature: 35, FrictionFactor: 80, TapWaterTemperature: 10},
+			want:     &domain.WaterTemperature{Temperature: -85, IceNeeded: true, IceAmount: 650, WaterAmount: 0},
+		},
+		{
+			name:            "with preferment",
+			prefermentSplit: prefermentSplit,
+			readings:        domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 20, RoomTemperature: 22, PrefermentTemperature: 18, FrictionFactor: 12},
+			want:            &domain.WaterTemperature{Temperature: 24, WaterAmount: 300},
+		},
+		{
+			name:            "preferment at room temperature",
+			prefermentSplit: prefermentSplit,
+			readings:        domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 20, RoomTemperature: 22, FrictionFactor: 12},
+			want:            &domain.WaterTemperature{Temperature: 20, WaterAmount: 300},
+		},
+		{
+			name:       "water to mix with process loss",
+			doughToMix: doughToMix,
+			readings:   domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 20, RoomTemperature: 22, FrictionFactor: 12},
+			want:       &domain.WaterTemperature{Temperature: 18, WaterAmount: 715},
+		},
+		{
+			name:            "preferment with process loss",
+			prefermentSplit: prefermentSplit,
+			doughToMix:      doughToMix,
+			readings:        domain.DoughTemperatureReadings{DesiredDoughTemperature: 24, FlourTemperature: 20, RoomTemperature: 22, PrefermentTemperature: 18, FrictionFactor: 12},
+			want:            &domain.WaterTemperature{Temperature: 24, WaterAmount: 330},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeAggregate := domain.RecipeAggregate{PrefermentSplit: tt.prefermentSplit, DoughToMix: tt.doughToMix}
+			recipeAggregate.Dough = dough
+
+			result, err := balancer.CalculateWaterTemperature(context.Background(), recipeAggregate, tt.readings)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	t.Run("invalid readings", func(t *testing.T) {
+		recipeAggregate := domain.RecipeAggregate{}
+		recipeAggregate.Dough = dough
+
+		result, err := balancer.CalculateWaterTemperature(context.Background(), recipeAggregate, domain.DoughTemperatureReadings{})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "invalid desired dough temperature")
+	})
+
+	t.Run("no water", func(t *testing.T) {
+		recipeAggregate := domain.RecipeAggregate{}
+		recipeAggregate.Dough = domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 1000}}}
+
+		result, err := balancer.CalculateWaterTemperature(context.Background(), recipeAggregate, domain.DoughTemperatureReadings{DesiredDoughTemperature: 24})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "dough has no water to temper")
+	})
+}
@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const (
+	yeastTypeStarter     = "starter"
+	defaultStarterFactor = 10
+	starterIngredient    = "sourdough starter"
+)
+
+var yeastTypeKeywords = []struct {
+	yeastType string
+	keywords  []string
+}{
+	{yeastType: yeastTypeInstant, keywords: []string{"instant", "istantaneo"}},
+	{yeastType: yeastTypeDry, keywords: []string{"dry", "secco"}},
+}
+
+func (bs IngredientsBalancerService) SubstituteYeast(ctx context.Context, recipe domain.Recipe, substitution domain.YeastSubstitution) (domain.Recipe, []string, error) {
+	factors, err := substitutionFactors(substitution.Factors)
+	if err != nil {
+		return recipe, nil, err
+	}
+	target := canonicalName(substitution.Target)
+	if _, ok := factors[target]; !ok {
+		return recipe, nil, errors.New("unknown yeast substitution target: " + substitution.Target)
+	}
+
+	dough := recipe.Dough
+	if dough.Fermentation != nil {
+		if target == yeastTypeStarter {
+			return recipe, nil, errors.New("fermentation model requires commercial yeast")
+		}
+		fermentation := *dough.Fermentation
+		source := yeastType(fermentation)
+		fermentation.YeastType = target
+		dough.Fermentation = &fermentation
+		dough.Ingredients = make([]domain.Ingredient, len(recipe.Dough.Ingredients))
+		for i, ingredient := range recipe.Dough.Ingredients {
+			dough.Ingredients[i] = ingredient
+			if ingredientKind(ingredient) != ingredientKindYeast {
+				continue
+			}
+			ingredientType, _ := leaveningType(ingredient)
+			dough.Ingredients[i].Name = leaveningName(target)
+			dough.Ingredients[i].Type = ingredientKindYeast
+			dough.Ingredients[i].Amount = ingredient.Amount / factors[ingredientType] * factors[target]
+		}
+		recipe.Dough = dough
+
+		var notes []string
+		if source != target {
+			notes = append(notes, fmt.Sprintf("%s replaced with %s in the fermentation model", leaveningName(source), leaveningName(target)))
+		}
+		return recipe, notes, nil
+	}
+
+	var (
+		ingredients     []domain.Ingredient
+		sources         []string
+		freshEquivalent float64
+		flourDelta      float64
+		waterDelta      float64
+		position        = -1
+	)
+	for _, ingredient := range dough.Ingredients {
+		source, ok := leaveningType(ingredient)
+		if !ok {
+			ingredients = append(ingredients, ingredient)
+			continue
+		}
+
+		freshEquivalent += ingredient.Amount / factors[source]
+		if source == yeastTypeStarter {
+			starterFlour, starterWater := starterComposition(ingredient)
+			flourDelta += starterFlour
+			waterDelta += starterWater
+		}
+		if source != target && !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+		if position < 0 {
+			position = len(ingredients)
+			ingredients = append(ingredients, domain.Ingredient{})
+		}
+	}
+	if position < 0 {
+		return recipe, nil, errors.New("recipe has no yeast to substitute")
+	}
+
+	replacement := domain.Ingredient{
+		Name:   leaveningName(target),
+		Amount: freshEquivalent * factors[target],
+		Type:   ingredientKindYeast,
+	}
+	if target == yeastTypeStarter {
+		replacement.Type = ingredientKindStarter
+		replacement.Hydration = substitution.StarterHydration
+		if replacement.Hydration <= 0 {
+			replacement.Hydration = defaultStarterHydration
+		}
+		starterFlour, starterWater := starterComposition(replacement)
+		flourDelta -= starterFlour
+		waterDelta -= starterWater
+	}
+	ingredients[position] = replacement
+
+	ingredients, err = adjustKindAmount(ingredients, ingredientKindFlour, flourDelta)
+	if err != nil {
+		return recipe, nil, err
+	}
+	ingredients, err = adjustKindAmount(ingredients, ingredientKindWater, waterDelta)
+	if err != nil {
+		return recipe, nil, err
+	}
+
+	dough.Ingredients = ingredients
+	recipe.Dough = dough
+
+	var notes []string
+	for _, source := range sources {
+		notes = append(notes, fmt.Sprintf("%s replaced with %s at %.3g g per g of %s", leaveningName(source), leaveningName(target), factors[target]/factors[source], leaveningName(source)))
+		if source == yeastTypeStarter {
+			notes = append(notes, "flour and water carried by the sourdough starter added back to the dough")
+		}
+	}
+	if target == yeastTypeStarter && len(sources) > 0 {
+		notes = append(notes, "flour and water carried by the sourdough starter deducted from the dough")
+	}
+
+	return recipe, notes, nil
+}
+
+func substitutionFactors(custom map[string]float64) (map[string]float64, error) {
+	factors := map[string]float64{yeastTypeStarter: defaultStarterFactor}
+	for yeastType, factor := range yeastTypeFactors {
+		factors[yeastType] = factor
+	}
+	for yeastType, factor := range custom {
+		if factor <= 0 {
+			return nil, errors.New("invalid substitution factor for " + yeastType)
+		}
+		factors[canonicalName(yeastType)] = factor
+	}
+	return factors, nil
+}
+
+func leaveningType(ingredient domain.Ingredient) (string, bool) {
+	switch ingredientKind(ingredient) {
+	case ingredientKindStarter:
+		return yeastTypeStarter, true
+	case ingredientKindYeast:
+		name := canonicalName(ingredient.Name)
+		for _, entry := range yeastTypeKeywords {
+			for _, keyword := range entry.keywords {
+				if strings.Contains(name, keyword) {
+					return entry.yeastType, true
+				}
+			}
+		}
+		return yeastTypeFresh, true
+	}
+	return "", false
+}
+
+func leaveningName(yeastType string) string {
+	if yeastType == yeastTypeStarter {
+		return starterIngredient
+	}
+	return yeastType + " " + ingredientKindYeast
+}
+
+func adjustKindAmount(ingredients []domain.Ingredient, kind string, delta float64) ([]domain.Ingredient, error) {
+	if delta == 0 {
+		return ingredients, nil
+	}
+	amount := sumIngredientsOfKind(ingredients, kind) + delta
+	if amount < 0 {
+		return nil, errors.New("not enough " + kind + " to build the sourdough starter")
+	}
+	return setKindAmount(ingredients, kind, kind, amount), nil
+}
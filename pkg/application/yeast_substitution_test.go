@@ -0,0 +1,176 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+func TestSubstituteYeast(t *testing.T) {
+	yeastedDough := domain.Dough{
+		Ingredients: []domain.Ingredient{
+			{Name: "flour", Amount: 60},
+			{Name: "water", Amount: 38},
+			{Name: "fresh yeast", Amount: 0.3},
+			{Name: "salt", Amount: 1.7},
+		},
+	}
+	sourdough := domain.Dough{
+		Ingredients: []domain.Ingredient{
+			{Name: "flour", Amount: 50},
+			{Name: "water", Amount: 30},
+			{Name: "levain", Amount: 20, Hydration: 100},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		dough        domain.Dough
+		substitution domain.YeastSubstitution
+		ingredients  []domain.Ingredient
+		notes        []string
+	}{
+		{
+			name:         "fresh to instant yeast",
+			dough:        yeastedDough,
+			substitution: domain.YeastSubstitution{Target: "Instant"},
+			ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 38},
+				{Name: "instant yeast", Amount: 0.099, Type: "yeast"},
+				{Name: "salt", Amount: 1.7},
+			},
+			notes: []string{"fresh yeast replaced with instant yeast at 0.33 g per g of fresh yeast"},
+		},
+		{
+			name:         "custom factor",
+			dough:        yeastedDough,
+			substitution: domain.YeastSubstitution{Target: "instant", Factors: map[string]float64{"instant": 0.25}},
+			ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 38},
+				{Name: "instant yeast", Amount: 0.075, Type: "yeast"},
+				{Name: "salt", Amount: 1.7},
+			},
+			notes: []string{"fresh yeast replaced with instant yeast at 0.25 g per g of fresh yeast"},
+		},
+		{
+			name:         "fresh yeast to starter",
+			dough:        yeastedDough,
+			substitution: domain.YeastSubstitution{Target: "starter"},
+			ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 58.5},
+				{Name: "water", Amount: 36.5},
+				{Name: "sourdough starter", Amount: 3, Type: "starter", Hydration: 100},
+				{Name: "salt", Amount: 1.7},
+			},
+			notes: []string{
+				"fresh yeast replaced with sourdough starter at 10 g per g of fresh yeast",
+				"flour and water carried by the sourdough starter deducted from the dough",
+			},
+		},
+		{
+			name:         "starter to instant yeast",
+			dough:        sourdough,
+			substitution: domain.YeastSubstitution{Target: "instant"},
+			ingredients: []domain.Ingredient{
+				{Name: "flour", Amount: 60},
+				{Name: "water", Amount: 40},
+				{Name: "instant yeast", Amount: 0.66, Type: "yeast"},
+			},
+			notes: []string{
+				"sourdough starter replaced with instant yeast at 0.033 g per g of sourdough starter",
+				"flour and water carried by the sourdough starter added back to the dough",
+			},
+		},
+	}
+
+	balancer := NewIngredientsBalancerService(&MockBalancerMetrics{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe, notes, err := balancer.SubstituteYeast(context.Background(), domain.Recipe{Dough: tt.dough}, tt.substitution)
+
+			assert.NoError(t, err)
+			assert.Len(t, recipe.Dough.Ingredients, len(tt.ingredients))
+			for i, ingredient := range tt.ingredients {
+				assert.Equal(t, ingredient.Name, recipe.Dough.Ingredients[i].Name)
+				assert.Equal(t, ingredient.Type, recipe.Dough.Ingredients[i].Type)
+				assert.Equal(t, ingredient.Hydration, recipe.Dough.Ingredients[i].Hydration)
+				assert.InDelta(t, ingredient.Amount, recipe.Dough.Ingredients[i].Amount, 1e-9)
+			}
+			assert.Equal(t, tt.notes, notes)
+		})
+	}
+
+	t.Run("fermentation model", func(t *testing.T) {
+		dough := yeastedDough
+		dough.Fermentation = &domain.Fermentation{Hours: 24, Temperature: 20}
+
+		recipe, notes, err := balancer.SubstituteYeast(context.Background(), domain.Recipe{Dough: dough}, domain.YeastSubstitution{Target: "dry"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "dry", recipe.Dough.Fermentation.YeastType)
+		assert.Equal(t, "", dough.Fermentation.YeastType)
+		assert.Equal(t, "dry yeast", recipe.Dough.Ingredients[2].Name)
+		assert.Equal(t, "yeast", recipe.Dough.Ingredients[2].Type)
+		assert.InDelta(t, 0.12, recipe.Dough.Ingredients[2].Amount, 1e-9)
+		assert.Equal(t, "fresh yeast", dough.Ingredients[2].Name)
+
+		balanced, err := balancer.Balance(context.Background(), recipe, domain.Pans{TotalArea: 1000, Pans: []domain.Pan{{Name: "teglia", Area: 1000}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "dry yeast", balanced.Dough.Ingredients[2].Name)
+		assert.Equal(t, balanced.Leavening.DryYeast, balanced.Dough.Ingredients[2].Amount)
+		assert.Equal(t, []string{"fresh yeast replaced with dry yeast in the fermentation model"}, notes)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fermentedDough := yeastedDough
+		fermentedDough.Fermentation = &domain.Fermentation{Hours: 24, Temperature: 20}
+
+		errorTests := []struct {
+			dough        domain.Dough
+			substitution domain.YeastSubstitution
+			err          string
+		}{
+			{dough: yeastedDough, substitution: domain.YeastSubstitution{Target: "beer"}, err: "unknown yeast substitution target: beer"},
+			{dough: yeastedDough, substitution: domain.YeastSubstitution{Target: "dry", Factors: map[string]float64{"dry": 0}}, err: "invalid substitution factor for dry"},
+			{dough: domain.Dough{Ingredients: []domain.Ingredient{{Name: "flour", Amount: 100}}}, substitution: domain.YeastSubstitution{Target: "dry"}, err: "recipe has no yeast to substitute"},
+			{dough: yeastedDough, substitution: domain.YeastSubstitution{Target: "starter", Factors: map[string]float64{"starter": 500}}, err: "not enough flour to build the sourdough starter"},
+			{dough: fermentedDough, substitution: domain.YeastSubstitution{Target: "starter"}, err: "fermentation model requires commercial yeast"},
+		}
+
+		for _, tt := range errorTests {
+			_, _, err := balancer.SubstituteYeast(context.Background(), domain.Recipe{Dough: tt.dough}, tt.substitution)
+			assert.EqualError(t, err, tt.err)
+		}
+	})
+}
+
+func TestLeaveningType(t *testing.T) {
+	tests := []struct {
+		ingredient domain.Ingredient
+		want       string
+		ok         bool
+	}{
+		{ingredient: domain.Ingredient{Name: "Lievito di birra"}, want: "fresh", ok: true},
+		{ingredient: domain.Ingredient{Name: "Lievito secco"}, want: "dry", ok: true},
+		{ingredient: domain.Ingredient{Name: "active dry yeast"}, want: "dry", ok: true},
+		{ingredient: domain.Ingredient{Name: "instant yeast"}, want: "instant", ok: true},
+		{ingredient: domain.Ingredient{Name: "Pasta madre"}, want: "starter", ok: true},
+		{ingredient: domain.Ingredient{Name: "flour"}, want: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ingredient.Name, func(t *testing.T) {
+			yeastType, ok := leaveningType(tt.ingredient)
+
+			assert.Equal(t, tt.want, yeastType)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+type DoughBallGroup struct {
+	Name            string
+	Count           int
+	BallWeight      float64
+	Diameter        float64
+	ThicknessFactor float64
+	ToppingRecipe   string
+}
+
+type DoughBallPortion struct {
+	Name        string
+	Count       int
+	BallWeight  float64
+	Ingredients []Ingredient
+}
@@ -0,0 +1,17 @@
+package domain
+
+type DoughTemperatureReadings struct {
+	DesiredDoughTemperature float64
+	FlourTemperature        float64
+	RoomTemperature         float64
+	PrefermentTemperature   float64
+	FrictionFactor          float64
+	TapWaterTemperature     float64
+}
+
+type WaterTemperature struct {
+	Temperature float64
+	IceNeeded   bool
+	IceAmount   float64
+	WaterAmount float64
+}
@@ -0,0 +1,15 @@
+package domain
+
+type Fermentation struct {
+	Hours       float64
+	Temperature float64
+	YeastType   string
+}
+
+type Leavening struct {
+	YeastType       string
+	YeastPercentage float64
+	FreshYeast      float64
+	InstantYeast    float64
+	DryYeast        float64
+}
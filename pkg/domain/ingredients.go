@@ -1,23 +1,70 @@
 package domain
 
 type SplitIngredients struct {
-	SplitDough   []Dough
-	SplitTopping []Topping
+	SplitDough          []Dough
+	SplitTopping        []Topping
+	SplitToppingLayers  []PanToppingLayers
+	SplitTopCrust       []Dough
+	SplitPanPreparation []PanPreparation
+	SplitSlices         []PanSlices
 }
 
 type Dough struct {
 	Name             string
 	PercentVariation float64
 	Ingredients      []Ingredient
+	Preferment       *Preferment
+	Targets          DoughTargets
+	Fermentation     *Fermentation
+	WaterTemperature *WaterTemperature
+	ProcessLoss      *ProcessLoss
+}
+
+type DoughTargets struct {
+	Hydration float64
+	Salt      float64
+	Yeast     float64
+}
+
+type SolvedTargets struct {
+	TotalFlour float64
+	Water      float64
+	Salt       float64
+	Yeast      float64
 }
 
 type Topping struct {
 	Name          string
 	ReferenceArea float64
 	Ingredients   []Ingredient
+	Stage         string
+	Scaling       string
 }
 
-type Ingredient struct {
+type PanToppingLayers struct {
 	Name   string
-	Amount float64
+	Layers []Topping
+}
+
+type PanSlices struct {
+	Name    string
+	Count   int
+	Weight  float64
+	Dough   []Ingredient
+	Topping []Ingredient
+}
+
+type ToppingGroup struct {
+	Name    string
+	Pans    []string
+	Area    float64
+	Topping Topping
+}
+
+type Ingredient struct {
+	Name      string
+	Amount    float64
+	Type      string
+	Hydration float64
+	Scaling   string
 }
@@ -0,0 +1,26 @@
+package domain
+
+type MixerProfile struct {
+	MaxDoughWeight float64
+	MinDoughWeight float64
+	BowlCount      int
+}
+
+type MixingBatch struct {
+	Number      int
+	Bowl        int
+	DoughWeight float64
+	Ingredients []Ingredient
+	Pans        []PanPortion
+}
+
+type MixingPlan struct {
+	Batches           []MixingBatch
+	DoughToMix        *Dough
+	ProcessLossWeight float64
+}
+
+type PanPortion struct {
+	Name        string
+	DoughWeight float64
+}
@@ -0,0 +1,18 @@
+package domain
+
+type ServingsTarget struct {
+	Servings       int
+	SlicesPerShape map[string]int
+}
+
+type PanAssortment struct {
+	Selections    []PanSelection
+	Servings      int
+	LeftoverDough float64
+	Utilization   float64
+}
+
+type PanOptimizationResult struct {
+	Assortment      PanAssortment
+	RecipeAggregate RecipeAggregate
+}
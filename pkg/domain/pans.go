@@ -1,20 +1,83 @@
 package domain
 
 type Pans struct {
-	Pans      []Pan
-	TotalArea float64
+	Pans             []Pan
+	TotalArea        float64
+	DefaultRimWidths map[string]float64
+	Profiles         []PanProfile
 }
 
 type Pan struct {
-	Shape    string
-	Measures Measures
-	Name     string
-	Area     float64
+	Shape               string
+	Measures            Measures
+	Name                string
+	Area                float64
+	Slices              int
+	ToppingRecipe       string
+	Sections            []PanSection
+	RimWidth            float64
+	Deep                bool
+	TopCrustShare       float64
+	Material            string
+	Style               string
+	Profile             string
+	ThicknessMultiplier float64
+	Slicing             *SlicingPattern
+}
+
+type PanProfile struct {
+	Name          string
+	Material      string
+	Style         string
+	ReferenceArea float64
+	Ingredients   []Ingredient
+}
+
+type PanPreparation struct {
+	Name        string
+	Profile     string
+	Ingredients []Ingredient
+}
+
+type SlicingPattern struct {
+	Pattern string
+	Rows    int
+	Columns int
+	Slices  int
+}
+
+type PanSection struct {
+	Name          string
+	Fraction      float64
+	ToppingRecipe string
 }
 
 type Measures struct {
-	Diameter *int
-	Edge     *int
-	Width    *int
-	Length   *int
+	Unit         string
+	Diameter     *float64
+	Edge         *float64
+	Width        *float64
+	Length       *float64
+	Height       *float64
+	WallCoverage *float64
+	MajorAxis    *float64
+	MinorAxis    *float64
+	Sides        *int
+	CornerRadius *float64
+	Vertices     []Point
+}
+
+type Point struct {
+	X float64
+	Y float64
+}
+
+type PanCandidate struct {
+	Pan         Pan
+	MaxQuantity *int
+}
+
+type PanSelection struct {
+	Pan      Pan
+	Quantity int
 }
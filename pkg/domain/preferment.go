@@ -0,0 +1,13 @@
+package domain
+
+type Preferment struct {
+	Type            string
+	Hydration       float64
+	FlourPercentage float64
+	YeastPercentage float64
+}
+
+type PrefermentSplit struct {
+	Preferment Dough
+	FinalDough Dough
+}
@@ -0,0 +1,7 @@
+package domain
+
+type ProcessLoss struct {
+	MixerPercentage float64
+	MinimumLoss     float64
+	PerBatchLoss    float64
+}
@@ -6,16 +6,39 @@ import (
 
 type RecipeAggregate struct {
 	Recipe
-	SplitIngredients SplitIngredients
+	SplitIngredients   SplitIngredients
+	MixingBatches      []MixingBatch
+	DoughBalls         []DoughBallPortion
+	SurplusDough       Dough
+	PrefermentSplit    *PrefermentSplit
+	FormulaPercentages []Ingredient
+	EffectiveHydration float64
+	Warnings           []string
+	SolvedTargets      *SolvedTargets
+	Leavening          *Leavening
+	Notes              []string
+	AppliedRules       []RuleApplication
+	ToppingGroups      []ToppingGroup
+	ToppingArea        float64
+	PanPreparation     []Ingredient
+	DoughToMix         *Dough
+	ProcessLossWeight  float64
+}
+
+type RecipeValidation struct {
+	Valid  bool
+	Issues []string
 }
 
 type Recipe struct {
-	Id          int
-	Uuid        uuid.UUID
-	Name        string
-	Description string
-	Author      string
-	Dough       Dough
-	Topping     Topping
-	Steps       Steps
+	Id             int
+	Uuid           uuid.UUID
+	Name           string
+	Description    string
+	Author         string
+	Dough          Dough
+	Topping        Topping
+	ToppingLayers  []Topping
+	ToppingRecipes []Topping
+	Steps          Steps
 }
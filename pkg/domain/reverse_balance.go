@@ -0,0 +1,8 @@
+package domain
+
+type ReverseBalanceResult struct {
+	Selections      []PanSelection
+	RecipeAggregate RecipeAggregate
+	UsedAmount      float64
+	LeftoverAmount  float64
+}
@@ -0,0 +1,24 @@
+package domain
+
+type PanScenario struct {
+	Name string
+	Pans Pans
+}
+
+type IngredientPrice struct {
+	Name     string
+	Price    float64
+	Quantity float64
+}
+
+type ScenarioComparison struct {
+	Name                string
+	RecipeAggregate     RecipeAggregate
+	TotalDough          float64
+	TotalTopping        float64
+	Servings            int
+	Leftover            float64
+	Utilization         float64
+	Cost                *float64
+	UnpricedIngredients []string
+}
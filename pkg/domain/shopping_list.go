@@ -0,0 +1,20 @@
+package domain
+
+type ShoppingList struct {
+	Items []ShoppingItem
+}
+
+type ShoppingItem struct {
+	Name           string
+	RequiredAmount float64
+	StockAmount    float64
+	NeededAmount   float64
+	PackageSize    float64
+	Packages       int
+	PurchaseAmount float64
+}
+
+type PackageSize struct {
+	Name string
+	Size float64
+}
@@ -0,0 +1,26 @@
+package domain
+
+type SubstitutionRule struct {
+	Name        string
+	Ingredient  string
+	Kind        string
+	Replacement string
+	Factor      float64
+	Fraction    float64
+	Additions   []Ingredient
+}
+
+type SubstitutionCatalog struct {
+	RuleSets map[string][]SubstitutionRule
+	Tenants  map[string][]string
+}
+
+type RuleApplication struct {
+	RuleSet           string
+	Rule              string
+	Section           string
+	Ingredient        string
+	Replacement       string
+	ReplacedAmount    float64
+	ReplacementAmount float64
+}
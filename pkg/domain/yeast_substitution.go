@@ -0,0 +1,7 @@
+package domain
+
+type YeastSubstitution struct {
+	Target           string
+	Factors          map[string]float64
+	StarterHydration float64
+}
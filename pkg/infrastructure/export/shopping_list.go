@@ -0,0 +1,72 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+var shoppingListCSVHeader = []string{
+	"name",
+	"required_amount",
+	"stock_amount",
+	"needed_amount",
+	"package_size",
+	"packages",
+	"purchase_amount",
+}
+
+type shoppingItemJSON struct {
+	Name           string  `json:"name"`
+	RequiredAmount float64 `json:"required_amount"`
+	StockAmount    float64 `json:"stock_amount"`
+	NeededAmount   float64 `json:"needed_amount"`
+	PackageSize    float64 `json:"package_size,omitempty"`
+	Packages       int     `json:"packages,omitempty"`
+	PurchaseAmount float64 `json:"purchase_amount"`
+}
+
+type shoppingListJSON struct {
+	Items []shoppingItemJSON `json:"items"`
+}
+
+func ShoppingListToJSON(shoppingList domain.ShoppingList) ([]byte, error) {
+	items := make([]shoppingItemJSON, 0, len(shoppingList.Items))
+	for _, item := range shoppingList.Items {
+		items = append(items, shoppingItemJSON(item))
+	}
+	return json.Marshal(shoppingListJSON{Items: items})
+}
+
+func ShoppingListToCSV(shoppingList domain.ShoppingList) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	if err := writer.Write(shoppingListCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, item := range shoppingList.Items {
+		record := []string{
+			item.Name,
+			formatAmount(item.RequiredAmount),
+			formatAmount(item.StockAmount),
+			formatAmount(item.NeededAmount),
+			formatAmount(item.PackageSize),
+			strconv.Itoa(item.Packages),
+			formatAmount(item.PurchaseAmount),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+
+	return buffer.Bytes(), writer.Error()
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
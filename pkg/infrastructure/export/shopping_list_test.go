@@ -0,0 +1,62 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+var testShoppingList = domain.ShoppingList{
+	Items: []domain.ShoppingItem{
+		{
+			Name:           "flour",
+			RequiredAmount: 30000,
+			StockAmount:    6000,
+			NeededAmount:   24000,
+			PackageSize:    25000,
+			Packages:       1,
+			PurchaseAmount: 25000,
+		},
+		{
+			Name:           "water",
+			RequiredAmount: 14000.5,
+			NeededAmount:   14000.5,
+			PurchaseAmount: 14000.5,
+		},
+	},
+}
+
+func TestShoppingListToJSON(t *testing.T) {
+	data, err := ShoppingListToJSON(testShoppingList)
+	require.NoError(t, err)
+
+	var decoded shoppingListJSON
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Len(t, decoded.Items, 2)
+	assert.Equal(t, "flour", decoded.Items[0].Name)
+	assert.Equal(t, 1, decoded.Items[0].Packages)
+	assert.Equal(t, 25000.0, decoded.Items[0].PurchaseAmount)
+	assert.NotContains(t, string(data), `"packages":0`)
+}
+
+func TestShoppingListToCSV(t *testing.T) {
+	data, err := ShoppingListToCSV(testShoppingList)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, "name,required_amount,stock_amount,needed_amount,package_size,packages,purchase_amount", lines[0])
+	assert.Equal(t, "flour,30000,6000,24000,25000,1,25000", lines[1])
+	assert.Equal(t, "water,14000.5,0,14000.5,0,0,14000.5", lines[2])
+}
+
+func TestShoppingListToCSV_Empty(t *testing.T) {
+	data, err := ShoppingListToCSV(domain.ShoppingList{})
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join(shoppingListCSVHeader, ",")+"\n", string(data))
+}
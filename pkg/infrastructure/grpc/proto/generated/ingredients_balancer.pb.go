@@ -25,8 +25,11 @@ type Ingredient struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount    float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Hydration float64 `protobuf:"fixed64,4,opt,name=hydration,proto3" json:"hydration,omitempty"`
+	Scaling   string  `protobuf:"bytes,5,opt,name=scaling,proto3" json:"scaling,omitempty"`
 }
 
 func (x *Ingredient) Reset() {
@@ -75,18 +78,40 @@ func (x *Ingredient) GetAmount() float64 {
 	return 0
 }
 
-type Dough struct {
+func (x *Ingredient) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Ingredient) GetHydration() float64 {
+	if x != nil {
+		return x.Hydration
+	}
+	return 0
+}
+
+func (x *Ingredient) GetScaling() string {
+	if x != nil {
+		return x.Scaling
+	}
+	return ""
+}
+
+type Preferment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PercentVariation float64       `protobuf:"fixed64,2,opt,name=percent_variation,json=percentVariation,proto3" json:"percent_variation,omitempty"`
-	Ingredients      []*Ingredient `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Type            string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hydration       float64 `protobuf:"fixed64,2,opt,name=hydration,proto3" json:"hydration,omitempty"`
+	FlourPercentage float64 `protobuf:"fixed64,3,opt,name=flour_percentage,json=flourPercentage,proto3" json:"flour_percentage,omitempty"`
+	YeastPercentage float64 `protobuf:"fixed64,4,opt,name=yeast_percentage,json=yeastPercentage,proto3" json:"yeast_percentage,omitempty"`
 }
 
-func (x *Dough) Reset() {
-	*x = Dough{}
+func (x *Preferment) Reset() {
+	*x = Preferment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -94,13 +119,13 @@ func (x *Dough) Reset() {
 	}
 }
 
-func (x *Dough) String() string {
+func (x *Preferment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dough) ProtoMessage() {}
+func (*Preferment) ProtoMessage() {}
 
-func (x *Dough) ProtoReflect() protoreflect.Message {
+func (x *Preferment) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -112,44 +137,56 @@ func (x *Dough) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dough.ProtoReflect.Descriptor instead.
-func (*Dough) Descriptor() ([]byte, []int) {
+// Deprecated: Use Preferment.ProtoReflect.Descriptor instead.
+func (*Preferment) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Dough) GetName() string {
+func (x *Preferment) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Dough) GetPercentVariation() float64 {
+func (x *Preferment) GetHydration() float64 {
 	if x != nil {
-		return x.PercentVariation
+		return x.Hydration
 	}
 	return 0
 }
 
-func (x *Dough) GetIngredients() []*Ingredient {
+func (x *Preferment) GetFlourPercentage() float64 {
 	if x != nil {
-		return x.Ingredients
+		return x.FlourPercentage
 	}
-	return nil
+	return 0
 }
 
-type Topping struct {
+func (x *Preferment) GetYeastPercentage() float64 {
+	if x != nil {
+		return x.YeastPercentage
+	}
+	return 0
+}
+
+type Dough struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ReferenceArea float64       `protobuf:"fixed64,2,opt,name=reference_area,json=referenceArea,proto3" json:"reference_area,omitempty"`
-	Ingredients   []*Ingredient `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Name             string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PercentVariation float64           `protobuf:"fixed64,2,opt,name=percent_variation,json=percentVariation,proto3" json:"percent_variation,omitempty"`
+	Ingredients      []*Ingredient     `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Preferment       *Preferment       `protobuf:"bytes,4,opt,name=preferment,proto3" json:"preferment,omitempty"`
+	Targets          *DoughTargets     `protobuf:"bytes,5,opt,name=targets,proto3" json:"targets,omitempty"`
+	Fermentation     *Fermentation     `protobuf:"bytes,6,opt,name=fermentation,proto3" json:"fermentation,omitempty"`
+	WaterTemperature *WaterTemperature `protobuf:"bytes,7,opt,name=water_temperature,json=waterTemperature,proto3" json:"water_temperature,omitempty"`
+	ProcessLoss      *ProcessLoss      `protobuf:"bytes,8,opt,name=process_loss,json=processLoss,proto3" json:"process_loss,omitempty"`
 }
 
-func (x *Topping) Reset() {
-	*x = Topping{}
+func (x *Dough) Reset() {
+	*x = Dough{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -157,13 +194,13 @@ func (x *Topping) Reset() {
 	}
 }
 
-func (x *Topping) String() string {
+func (x *Dough) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topping) ProtoMessage() {}
+func (*Dough) ProtoMessage() {}
 
-func (x *Topping) ProtoReflect() protoreflect.Message {
+func (x *Dough) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,44 +212,79 @@ func (x *Topping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Topping.ProtoReflect.Descriptor instead.
-func (*Topping) Descriptor() ([]byte, []int) {
+// Deprecated: Use Dough.ProtoReflect.Descriptor instead.
+func (*Dough) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Topping) GetName() string {
+func (x *Dough) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Topping) GetReferenceArea() float64 {
+func (x *Dough) GetPercentVariation() float64 {
 	if x != nil {
-		return x.ReferenceArea
+		return x.PercentVariation
 	}
 	return 0
 }
 
-func (x *Topping) GetIngredients() []*Ingredient {
+func (x *Dough) GetIngredients() []*Ingredient {
 	if x != nil {
 		return x.Ingredients
 	}
 	return nil
 }
 
-type Step struct {
+func (x *Dough) GetPreferment() *Preferment {
+	if x != nil {
+		return x.Preferment
+	}
+	return nil
+}
+
+func (x *Dough) GetTargets() *DoughTargets {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Dough) GetFermentation() *Fermentation {
+	if x != nil {
+		return x.Fermentation
+	}
+	return nil
+}
+
+func (x *Dough) GetWaterTemperature() *WaterTemperature {
+	if x != nil {
+		return x.WaterTemperature
+	}
+	return nil
+}
+
+func (x *Dough) GetProcessLoss() *ProcessLoss {
+	if x != nil {
+		return x.ProcessLoss
+	}
+	return nil
+}
+
+type ProcessLoss struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StepNumber  int32  `protobuf:"varint,2,opt,name=step_number,json=stepNumber,proto3" json:"step_number,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MixerPercentage float64 `protobuf:"fixed64,1,opt,name=mixer_percentage,json=mixerPercentage,proto3" json:"mixer_percentage,omitempty"`
+	MinimumLoss     float64 `protobuf:"fixed64,2,opt,name=minimum_loss,json=minimumLoss,proto3" json:"minimum_loss,omitempty"`
+	PerBatchLoss    float64 `protobuf:"fixed64,3,opt,name=per_batch_loss,json=perBatchLoss,proto3" json:"per_batch_loss,omitempty"`
 }
 
-func (x *Step) Reset() {
-	*x = Step{}
+func (x *ProcessLoss) Reset() {
+	*x = ProcessLoss{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -220,13 +292,13 @@ func (x *Step) Reset() {
 	}
 }
 
-func (x *Step) String() string {
+func (x *ProcessLoss) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Step) ProtoMessage() {}
+func (*ProcessLoss) ProtoMessage() {}
 
-func (x *Step) ProtoReflect() protoreflect.Message {
+func (x *ProcessLoss) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -238,43 +310,47 @@ func (x *Step) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Step.ProtoReflect.Descriptor instead.
-func (*Step) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessLoss.ProtoReflect.Descriptor instead.
+func (*ProcessLoss) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Step) GetId() int32 {
+func (x *ProcessLoss) GetMixerPercentage() float64 {
 	if x != nil {
-		return x.Id
+		return x.MixerPercentage
 	}
 	return 0
 }
 
-func (x *Step) GetStepNumber() int32 {
+func (x *ProcessLoss) GetMinimumLoss() float64 {
 	if x != nil {
-		return x.StepNumber
+		return x.MinimumLoss
 	}
 	return 0
 }
 
-func (x *Step) GetDescription() string {
+func (x *ProcessLoss) GetPerBatchLoss() float64 {
 	if x != nil {
-		return x.Description
+		return x.PerBatchLoss
 	}
-	return ""
+	return 0
 }
 
-type Steps struct {
+type DoughTemperatureReadings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecipeId int32   `protobuf:"varint,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	Steps    []*Step `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	DesiredDoughTemperature float64 `protobuf:"fixed64,1,opt,name=desired_dough_temperature,json=desiredDoughTemperature,proto3" json:"desired_dough_temperature,omitempty"`
+	FlourTemperature        float64 `protobuf:"fixed64,2,opt,name=flour_temperature,json=flourTemperature,proto3" json:"flour_temperature,omitempty"`
+	RoomTemperature         float64 `protobuf:"fixed64,3,opt,name=room_temperature,json=roomTemperature,proto3" json:"room_temperature,omitempty"`
+	PrefermentTemperature   float64 `protobuf:"fixed64,4,opt,name=preferment_temperature,json=prefermentTemperature,proto3" json:"preferment_temperature,omitempty"`
+	FrictionFactor          float64 `protobuf:"fixed64,5,opt,name=friction_factor,json=frictionFactor,proto3" json:"friction_factor,omitempty"`
+	TapWaterTemperature     float64 `protobuf:"fixed64,6,opt,name=tap_water_temperature,json=tapWaterTemperature,proto3" json:"tap_water_temperature,omitempty"`
 }
 
-func (x *Steps) Reset() {
-	*x = Steps{}
+func (x *DoughTemperatureReadings) Reset() {
+	*x = DoughTemperatureReadings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,13 +358,13 @@ func (x *Steps) Reset() {
 	}
 }
 
-func (x *Steps) String() string {
+func (x *DoughTemperatureReadings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Steps) ProtoMessage() {}
+func (*DoughTemperatureReadings) ProtoMessage() {}
 
-func (x *Steps) ProtoReflect() protoreflect.Message {
+func (x *DoughTemperatureReadings) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,42 +376,66 @@ func (x *Steps) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Steps.ProtoReflect.Descriptor instead.
-func (*Steps) Descriptor() ([]byte, []int) {
+// Deprecated: Use DoughTemperatureReadings.ProtoReflect.Descriptor instead.
+func (*DoughTemperatureReadings) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Steps) GetRecipeId() int32 {
+func (x *DoughTemperatureReadings) GetDesiredDoughTemperature() float64 {
 	if x != nil {
-		return x.RecipeId
+		return x.DesiredDoughTemperature
 	}
 	return 0
 }
 
-func (x *Steps) GetSteps() []*Step {
+func (x *DoughTemperatureReadings) GetFlourTemperature() float64 {
 	if x != nil {
-		return x.Steps
+		return x.FlourTemperature
 	}
-	return nil
+	return 0
 }
 
-type Recipe struct {
+func (x *DoughTemperatureReadings) GetRoomTemperature() float64 {
+	if x != nil {
+		return x.RoomTemperature
+	}
+	return 0
+}
+
+func (x *DoughTemperatureReadings) GetPrefermentTemperature() float64 {
+	if x != nil {
+		return x.PrefermentTemperature
+	}
+	return 0
+}
+
+func (x *DoughTemperatureReadings) GetFrictionFactor() float64 {
+	if x != nil {
+		return x.FrictionFactor
+	}
+	return 0
+}
+
+func (x *DoughTemperatureReadings) GetTapWaterTemperature() float64 {
+	if x != nil {
+		return x.TapWaterTemperature
+	}
+	return 0
+}
+
+type WaterTemperature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid        string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Author      string   `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	Dough       *Dough   `protobuf:"bytes,6,opt,name=dough,proto3" json:"dough,omitempty"`
-	Topping     *Topping `protobuf:"bytes,7,opt,name=topping,proto3" json:"topping,omitempty"`
-	Steps       *Steps   `protobuf:"bytes,8,opt,name=steps,proto3" json:"steps,omitempty"`
+	Temperature float64 `protobuf:"fixed64,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	IceNeeded   bool    `protobuf:"varint,2,opt,name=ice_needed,json=iceNeeded,proto3" json:"ice_needed,omitempty"`
+	IceAmount   float64 `protobuf:"fixed64,3,opt,name=ice_amount,json=iceAmount,proto3" json:"ice_amount,omitempty"`
+	WaterAmount float64 `protobuf:"fixed64,4,opt,name=water_amount,json=waterAmount,proto3" json:"water_amount,omitempty"`
 }
 
-func (x *Recipe) Reset() {
-	*x = Recipe{}
+func (x *WaterTemperature) Reset() {
+	*x = WaterTemperature{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +443,13 @@ func (x *Recipe) Reset() {
 	}
 }
 
-func (x *Recipe) String() string {
+func (x *WaterTemperature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe) ProtoMessage() {}
+func (*WaterTemperature) ProtoMessage() {}
 
-func (x *Recipe) ProtoReflect() protoreflect.Message {
+func (x *WaterTemperature) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,80 +461,51 @@ func (x *Recipe) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
-func (*Recipe) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaterTemperature.ProtoReflect.Descriptor instead.
+func (*WaterTemperature) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Recipe) GetId() int32 {
+func (x *WaterTemperature) GetTemperature() float64 {
 	if x != nil {
-		return x.Id
+		return x.Temperature
 	}
 	return 0
 }
 
-func (x *Recipe) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *Recipe) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Recipe) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Recipe) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
-}
-
-func (x *Recipe) GetDough() *Dough {
+func (x *WaterTemperature) GetIceNeeded() bool {
 	if x != nil {
-		return x.Dough
+		return x.IceNeeded
 	}
-	return nil
+	return false
 }
 
-func (x *Recipe) GetTopping() *Topping {
+func (x *WaterTemperature) GetIceAmount() float64 {
 	if x != nil {
-		return x.Topping
+		return x.IceAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *Recipe) GetSteps() *Steps {
+func (x *WaterTemperature) GetWaterAmount() float64 {
 	if x != nil {
-		return x.Steps
+		return x.WaterAmount
 	}
-	return nil
+	return 0
 }
 
-type Measures struct {
+type Fermentation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diameter *int32 `protobuf:"varint,1,opt,name=diameter,proto3,oneof" json:"diameter,omitempty"`
-	Edge     *int32 `protobuf:"varint,2,opt,name=edge,proto3,oneof" json:"edge,omitempty"`
-	Width    *int32 `protobuf:"varint,3,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Length   *int32 `protobuf:"varint,4,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	Hours       float64 `protobuf:"fixed64,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Temperature float64 `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	YeastType   string  `protobuf:"bytes,3,opt,name=yeast_type,json=yeastType,proto3" json:"yeast_type,omitempty"`
 }
 
-func (x *Measures) Reset() {
-	*x = Measures{}
+func (x *Fermentation) Reset() {
+	*x = Fermentation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +513,13 @@ func (x *Measures) Reset() {
 	}
 }
 
-func (x *Measures) String() string {
+func (x *Fermentation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Measures) ProtoMessage() {}
+func (*Fermentation) ProtoMessage() {}
 
-func (x *Measures) ProtoReflect() protoreflect.Message {
+func (x *Fermentation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,52 +531,46 @@ func (x *Measures) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Measures.ProtoReflect.Descriptor instead.
-func (*Measures) Descriptor() ([]byte, []int) {
+// Deprecated: Use Fermentation.ProtoReflect.Descriptor instead.
+func (*Fermentation) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Measures) GetDiameter() int32 {
-	if x != nil && x.Diameter != nil {
-		return *x.Diameter
-	}
-	return 0
-}
-
-func (x *Measures) GetEdge() int32 {
-	if x != nil && x.Edge != nil {
-		return *x.Edge
+func (x *Fermentation) GetHours() float64 {
+	if x != nil {
+		return x.Hours
 	}
 	return 0
 }
 
-func (x *Measures) GetWidth() int32 {
-	if x != nil && x.Width != nil {
-		return *x.Width
+func (x *Fermentation) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
 	}
 	return 0
 }
 
-func (x *Measures) GetLength() int32 {
-	if x != nil && x.Length != nil {
-		return *x.Length
+func (x *Fermentation) GetYeastType() string {
+	if x != nil {
+		return x.YeastType
 	}
-	return 0
+	return ""
 }
 
-type Pan struct {
+type Leavening struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shape    string    `protobuf:"bytes,1,opt,name=shape,proto3" json:"shape,omitempty"`
-	Measures *Measures `protobuf:"bytes,2,opt,name=measures,proto3" json:"measures,omitempty"`
-	Name     string    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Area     float64   `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	YeastType       string  `protobuf:"bytes,1,opt,name=yeast_type,json=yeastType,proto3" json:"yeast_type,omitempty"`
+	YeastPercentage float64 `protobuf:"fixed64,2,opt,name=yeast_percentage,json=yeastPercentage,proto3" json:"yeast_percentage,omitempty"`
+	FreshYeast      float64 `protobuf:"fixed64,3,opt,name=fresh_yeast,json=freshYeast,proto3" json:"fresh_yeast,omitempty"`
+	InstantYeast    float64 `protobuf:"fixed64,4,opt,name=instant_yeast,json=instantYeast,proto3" json:"instant_yeast,omitempty"`
+	DryYeast        float64 `protobuf:"fixed64,5,opt,name=dry_yeast,json=dryYeast,proto3" json:"dry_yeast,omitempty"`
 }
 
-func (x *Pan) Reset() {
-	*x = Pan{}
+func (x *Leavening) Reset() {
+	*x = Leavening{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,13 +578,13 @@ func (x *Pan) Reset() {
 	}
 }
 
-func (x *Pan) String() string {
+func (x *Leavening) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pan) ProtoMessage() {}
+func (*Leavening) ProtoMessage() {}
 
-func (x *Pan) ProtoReflect() protoreflect.Message {
+func (x *Leavening) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,50 +596,58 @@ func (x *Pan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pan.ProtoReflect.Descriptor instead.
-func (*Pan) Descriptor() ([]byte, []int) {
+// Deprecated: Use Leavening.ProtoReflect.Descriptor instead.
+func (*Leavening) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Pan) GetShape() string {
+func (x *Leavening) GetYeastType() string {
 	if x != nil {
-		return x.Shape
+		return x.YeastType
 	}
 	return ""
 }
 
-func (x *Pan) GetMeasures() *Measures {
+func (x *Leavening) GetYeastPercentage() float64 {
 	if x != nil {
-		return x.Measures
+		return x.YeastPercentage
 	}
-	return nil
+	return 0
 }
 
-func (x *Pan) GetName() string {
+func (x *Leavening) GetFreshYeast() float64 {
 	if x != nil {
-		return x.Name
+		return x.FreshYeast
 	}
-	return ""
+	return 0
 }
 
-func (x *Pan) GetArea() float64 {
+func (x *Leavening) GetInstantYeast() float64 {
 	if x != nil {
-		return x.Area
+		return x.InstantYeast
 	}
 	return 0
 }
 
-type Pans struct {
+func (x *Leavening) GetDryYeast() float64 {
+	if x != nil {
+		return x.DryYeast
+	}
+	return 0
+}
+
+type DoughTargets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pans      []*Pan  `protobuf:"bytes,1,rep,name=pans,proto3" json:"pans,omitempty"`
-	TotalArea float64 `protobuf:"fixed64,2,opt,name=total_area,json=totalArea,proto3" json:"total_area,omitempty"`
+	Hydration float64 `protobuf:"fixed64,1,opt,name=hydration,proto3" json:"hydration,omitempty"`
+	Salt      float64 `protobuf:"fixed64,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Yeast     float64 `protobuf:"fixed64,3,opt,name=yeast,proto3" json:"yeast,omitempty"`
 }
 
-func (x *Pans) Reset() {
-	*x = Pans{}
+func (x *DoughTargets) Reset() {
+	*x = DoughTargets{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,13 +655,13 @@ func (x *Pans) Reset() {
 	}
 }
 
-func (x *Pans) String() string {
+func (x *DoughTargets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pans) ProtoMessage() {}
+func (*DoughTargets) ProtoMessage() {}
 
-func (x *Pans) ProtoReflect() protoreflect.Message {
+func (x *DoughTargets) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,36 +673,45 @@ func (x *Pans) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pans.ProtoReflect.Descriptor instead.
-func (*Pans) Descriptor() ([]byte, []int) {
+// Deprecated: Use DoughTargets.ProtoReflect.Descriptor instead.
+func (*DoughTargets) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Pans) GetPans() []*Pan {
+func (x *DoughTargets) GetHydration() float64 {
 	if x != nil {
-		return x.Pans
+		return x.Hydration
 	}
-	return nil
+	return 0
 }
 
-func (x *Pans) GetTotalArea() float64 {
+func (x *DoughTargets) GetSalt() float64 {
 	if x != nil {
-		return x.TotalArea
+		return x.Salt
 	}
 	return 0
 }
 
-type SplitIngredients struct {
+func (x *DoughTargets) GetYeast() float64 {
+	if x != nil {
+		return x.Yeast
+	}
+	return 0
+}
+
+type SolvedTargets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SplitDough   []*Dough   `protobuf:"bytes,1,rep,name=split_dough,json=splitDough,proto3" json:"split_dough,omitempty"`
-	SplitTopping []*Topping `protobuf:"bytes,2,rep,name=split_topping,json=splitTopping,proto3" json:"split_topping,omitempty"`
+	TotalFlour float64 `protobuf:"fixed64,1,opt,name=total_flour,json=totalFlour,proto3" json:"total_flour,omitempty"`
+	Water      float64 `protobuf:"fixed64,2,opt,name=water,proto3" json:"water,omitempty"`
+	Salt       float64 `protobuf:"fixed64,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	Yeast      float64 `protobuf:"fixed64,4,opt,name=yeast,proto3" json:"yeast,omitempty"`
 }
 
-func (x *SplitIngredients) Reset() {
-	*x = SplitIngredients{}
+func (x *SolvedTargets) Reset() {
+	*x = SolvedTargets{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,13 +719,13 @@ func (x *SplitIngredients) Reset() {
 	}
 }
 
-func (x *SplitIngredients) String() string {
+func (x *SolvedTargets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SplitIngredients) ProtoMessage() {}
+func (*SolvedTargets) ProtoMessage() {}
 
-func (x *SplitIngredients) ProtoReflect() protoreflect.Message {
+func (x *SolvedTargets) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,36 +737,53 @@ func (x *SplitIngredients) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SplitIngredients.ProtoReflect.Descriptor instead.
-func (*SplitIngredients) Descriptor() ([]byte, []int) {
+// Deprecated: Use SolvedTargets.ProtoReflect.Descriptor instead.
+func (*SolvedTargets) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SplitIngredients) GetSplitDough() []*Dough {
+func (x *SolvedTargets) GetTotalFlour() float64 {
 	if x != nil {
-		return x.SplitDough
+		return x.TotalFlour
 	}
-	return nil
+	return 0
 }
 
-func (x *SplitIngredients) GetSplitTopping() []*Topping {
+func (x *SolvedTargets) GetWater() float64 {
 	if x != nil {
-		return x.SplitTopping
+		return x.Water
 	}
-	return nil
+	return 0
 }
 
-type RecipeAggregate struct {
+func (x *SolvedTargets) GetSalt() float64 {
+	if x != nil {
+		return x.Salt
+	}
+	return 0
+}
+
+func (x *SolvedTargets) GetYeast() float64 {
+	if x != nil {
+		return x.Yeast
+	}
+	return 0
+}
+
+type Topping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipe           *Recipe           `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
-	SplitIngredients *SplitIngredients `protobuf:"bytes,2,opt,name=split_ingredients,json=splitIngredients,proto3" json:"split_ingredients,omitempty"`
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReferenceArea float64       `protobuf:"fixed64,2,opt,name=reference_area,json=referenceArea,proto3" json:"reference_area,omitempty"`
+	Ingredients   []*Ingredient `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Stage         string        `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Scaling       string        `protobuf:"bytes,5,opt,name=scaling,proto3" json:"scaling,omitempty"`
 }
 
-func (x *RecipeAggregate) Reset() {
-	*x = RecipeAggregate{}
+func (x *Topping) Reset() {
+	*x = Topping{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +791,13 @@ func (x *RecipeAggregate) Reset() {
 	}
 }
 
-func (x *RecipeAggregate) String() string {
+func (x *Topping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeAggregate) ProtoMessage() {}
+func (*Topping) ProtoMessage() {}
 
-func (x *RecipeAggregate) ProtoReflect() protoreflect.Message {
+func (x *Topping) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,36 +809,57 @@ func (x *RecipeAggregate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeAggregate.ProtoReflect.Descriptor instead.
-func (*RecipeAggregate) Descriptor() ([]byte, []int) {
+// Deprecated: Use Topping.ProtoReflect.Descriptor instead.
+func (*Topping) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RecipeAggregate) GetRecipe() *Recipe {
+func (x *Topping) GetName() string {
 	if x != nil {
-		return x.Recipe
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeAggregate) GetSplitIngredients() *SplitIngredients {
+func (x *Topping) GetReferenceArea() float64 {
 	if x != nil {
-		return x.SplitIngredients
+		return x.ReferenceArea
+	}
+	return 0
+}
+
+func (x *Topping) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
 	}
 	return nil
 }
 
-type BalanceRequest struct {
+func (x *Topping) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Topping) GetScaling() string {
+	if x != nil {
+		return x.Scaling
+	}
+	return ""
+}
+
+type PanToppingLayers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
-	Pans   *Pans   `protobuf:"bytes,2,opt,name=pans,proto3" json:"pans,omitempty"`
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Layers []*Topping `protobuf:"bytes,2,rep,name=layers,proto3" json:"layers,omitempty"`
 }
 
-func (x *BalanceRequest) Reset() {
-	*x = BalanceRequest{}
+func (x *PanToppingLayers) Reset() {
+	*x = PanToppingLayers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,13 +867,13 @@ func (x *BalanceRequest) Reset() {
 	}
 }
 
-func (x *BalanceRequest) String() string {
+func (x *PanToppingLayers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceRequest) ProtoMessage() {}
+func (*PanToppingLayers) ProtoMessage() {}
 
-func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
+func (x *PanToppingLayers) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,35 +885,38 @@ func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
-func (*BalanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanToppingLayers.ProtoReflect.Descriptor instead.
+func (*PanToppingLayers) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BalanceRequest) GetRecipe() *Recipe {
+func (x *PanToppingLayers) GetName() string {
 	if x != nil {
-		return x.Recipe
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *BalanceRequest) GetPans() *Pans {
+func (x *PanToppingLayers) GetLayers() []*Topping {
 	if x != nil {
-		return x.Pans
+		return x.Layers
 	}
 	return nil
 }
 
-type BalanceResponse struct {
+type ToppingGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecipeAggregate *RecipeAggregate `protobuf:"bytes,1,opt,name=recipe_aggregate,json=recipeAggregate,proto3" json:"recipe_aggregate,omitempty"`
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pans    []string `protobuf:"bytes,2,rep,name=pans,proto3" json:"pans,omitempty"`
+	Area    float64  `protobuf:"fixed64,3,opt,name=area,proto3" json:"area,omitempty"`
+	Topping *Topping `protobuf:"bytes,4,opt,name=topping,proto3" json:"topping,omitempty"`
 }
 
-func (x *BalanceResponse) Reset() {
-	*x = BalanceResponse{}
+func (x *ToppingGroup) Reset() {
+	*x = ToppingGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,13 +924,13 @@ func (x *BalanceResponse) Reset() {
 	}
 }
 
-func (x *BalanceResponse) String() string {
+func (x *ToppingGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceResponse) ProtoMessage() {}
+func (*ToppingGroup) ProtoMessage() {}
 
-func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *ToppingGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -819,221 +942,4303 @@ func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
-func (*BalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToppingGroup.ProtoReflect.Descriptor instead.
+func (*ToppingGroup) Descriptor() ([]byte, []int) {
 	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BalanceResponse) GetRecipeAggregate() *RecipeAggregate {
+func (x *ToppingGroup) GetName() string {
 	if x != nil {
-		return x.RecipeAggregate
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToppingGroup) GetPans() []string {
+	if x != nil {
+		return x.Pans
 	}
 	return nil
 }
 
-var File_pkg_infrastructure_grpc_proto_ingredients_balancer_proto protoreflect.FileDescriptor
+func (x *ToppingGroup) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
 
-var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDesc = []byte{
-	0x0a, 0x38, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
-	0x22, 0x38, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x05, 0x44,
-	0x6f, 0x75, 0x67, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x07, 0x54, 0x6f,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
-	0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x56, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68,
-	0x52, 0x05, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x37, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
-	0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x05, 0x73, 0x74,
-	0x65, 0x70, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73,
-	0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x88, 0x01,
-	0x01, 0x12, 0x17, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x01, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88,
-	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x7f, 0x0a,
-	0x03, 0x50, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x6d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
-	0x65, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x54,
-	0x0a, 0x04, 0x50, 0x61, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x52,
-	0x04, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
-	0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x41, 0x72, 0x65, 0x61, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0a, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74,
-	0x5f, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x73,
-	0x70, 0x6c, 0x69, 0x74, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x9c, 0x01, 0x0a, 0x0f,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
-	0x34, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x0e, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x61,
-	0x6e, 0x73, 0x22, 0x63, 0x0a, 0x0f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
-	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x32, 0x6f, 0x0a, 0x13, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x58,
-	0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
-	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x66, 0x69, 0x6f, 0x72, 0x65, 0x74, 0x74, 0x69,
-	0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ToppingGroup) GetTopping() *Topping {
+	if x != nil {
+		return x.Topping
+	}
+	return nil
 }
 
-var (
-	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescOnce sync.Once
-	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData = file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDesc
-)
+type Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP() []byte {
-	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescOnce.Do(func() {
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData)
-	})
-	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StepNumber  int32  `protobuf:"varint,2,opt,name=step_number,json=stepNumber,proto3" json:"step_number,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_goTypes = []interface{}{
-	(*Ingredient)(nil),       // 0: ingredients_balancer.Ingredient
-	(*Dough)(nil),            // 1: ingredients_balancer.Dough
-	(*Topping)(nil),          // 2: ingredients_balancer.Topping
-	(*Step)(nil),             // 3: ingredients_balancer.Step
-	(*Steps)(nil),            // 4: ingredients_balancer.Steps
-	(*Recipe)(nil),           // 5: ingredients_balancer.Recipe
-	(*Measures)(nil),         // 6: ingredients_balancer.Measures
-	(*Pan)(nil),              // 7: ingredients_balancer.Pan
-	(*Pans)(nil),             // 8: ingredients_balancer.Pans
-	(*SplitIngredients)(nil), // 9: ingredients_balancer.SplitIngredients
-	(*RecipeAggregate)(nil),  // 10: ingredients_balancer.RecipeAggregate
-	(*BalanceRequest)(nil),   // 11: ingredients_balancer.BalanceRequest
-	(*BalanceResponse)(nil),  // 12: ingredients_balancer.BalanceResponse
+func (x *Step) Reset() {
+	*x = Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_depIdxs = []int32{
-	0,  // 0: ingredients_balancer.Dough.ingredients:type_name -> ingredients_balancer.Ingredient
-	0,  // 1: ingredients_balancer.Topping.ingredients:type_name -> ingredients_balancer.Ingredient
-	3,  // 2: ingredients_balancer.Steps.steps:type_name -> ingredients_balancer.Step
-	1,  // 3: ingredients_balancer.Recipe.dough:type_name -> ingredients_balancer.Dough
-	2,  // 4: ingredients_balancer.Recipe.topping:type_name -> ingredients_balancer.Topping
-	4,  // 5: ingredients_balancer.Recipe.steps:type_name -> ingredients_balancer.Steps
-	6,  // 6: ingredients_balancer.Pan.measures:type_name -> ingredients_balancer.Measures
-	7,  // 7: ingredients_balancer.Pans.pans:type_name -> ingredients_balancer.Pan
-	1,  // 8: ingredients_balancer.SplitIngredients.split_dough:type_name -> ingredients_balancer.Dough
-	2,  // 9: ingredients_balancer.SplitIngredients.split_topping:type_name -> ingredients_balancer.Topping
-	5,  // 10: ingredients_balancer.RecipeAggregate.recipe:type_name -> ingredients_balancer.Recipe
-	9,  // 11: ingredients_balancer.RecipeAggregate.split_ingredients:type_name -> ingredients_balancer.SplitIngredients
-	5,  // 12: ingredients_balancer.BalanceRequest.recipe:type_name -> ingredients_balancer.Recipe
-	8,  // 13: ingredients_balancer.BalanceRequest.pans:type_name -> ingredients_balancer.Pans
-	10, // 14: ingredients_balancer.BalanceResponse.recipe_aggregate:type_name -> ingredients_balancer.RecipeAggregate
-	11, // 15: ingredients_balancer.IngredientsBalancer.Balance:input_type -> ingredients_balancer.BalanceRequest
-	12, // 16: ingredients_balancer.IngredientsBalancer.Balance:output_type -> ingredients_balancer.BalanceResponse
-	16, // [16:17] is the sub-list for method output_type
-	15, // [15:16] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+
+func (x *Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() }
-func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
-	if File_pkg_infrastructure_grpc_proto_ingredients_balancer_proto != nil {
-		return
+func (*Step) ProtoMessage() {}
+
+func (x *Step) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ingredient); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Step.ProtoReflect.Descriptor instead.
+func (*Step) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Step) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Step) GetStepNumber() int32 {
+	if x != nil {
+		return x.StepNumber
+	}
+	return 0
+}
+
+func (x *Step) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Steps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipeId int32   `protobuf:"varint,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Steps    []*Step `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *Steps) Reset() {
+	*x = Steps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Steps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Steps) ProtoMessage() {}
+
+func (x *Steps) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Steps.ProtoReflect.Descriptor instead.
+func (*Steps) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Steps) GetRecipeId() int32 {
+	if x != nil {
+		return x.RecipeId
+	}
+	return 0
+}
+
+func (x *Steps) GetSteps() []*Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type Recipe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid           string     `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name           string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Author         string     `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Dough          *Dough     `protobuf:"bytes,6,opt,name=dough,proto3" json:"dough,omitempty"`
+	Topping        *Topping   `protobuf:"bytes,7,opt,name=topping,proto3" json:"topping,omitempty"`
+	Steps          *Steps     `protobuf:"bytes,8,opt,name=steps,proto3" json:"steps,omitempty"`
+	ToppingLayers  []*Topping `protobuf:"bytes,9,rep,name=topping_layers,json=toppingLayers,proto3" json:"topping_layers,omitempty"`
+	ToppingRecipes []*Topping `protobuf:"bytes,10,rep,name=topping_recipes,json=toppingRecipes,proto3" json:"topping_recipes,omitempty"`
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Recipe) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Recipe) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Recipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recipe) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Recipe) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Recipe) GetDough() *Dough {
+	if x != nil {
+		return x.Dough
+	}
+	return nil
+}
+
+func (x *Recipe) GetTopping() *Topping {
+	if x != nil {
+		return x.Topping
+	}
+	return nil
+}
+
+func (x *Recipe) GetSteps() *Steps {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Recipe) GetToppingLayers() []*Topping {
+	if x != nil {
+		return x.ToppingLayers
+	}
+	return nil
+}
+
+func (x *Recipe) GetToppingRecipes() []*Topping {
+	if x != nil {
+		return x.ToppingRecipes
+	}
+	return nil
+}
+
+type Measures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diameter      *int32   `protobuf:"varint,1,opt,name=diameter,proto3,oneof" json:"diameter,omitempty"`
+	Edge          *int32   `protobuf:"varint,2,opt,name=edge,proto3,oneof" json:"edge,omitempty"`
+	Width         *int32   `protobuf:"varint,3,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Length        *int32   `protobuf:"varint,4,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	Height        *float64 `protobuf:"fixed64,5,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	WallCoverage  *float64 `protobuf:"fixed64,6,opt,name=wall_coverage,json=wallCoverage,proto3,oneof" json:"wall_coverage,omitempty"`
+	MajorAxis     *float64 `protobuf:"fixed64,7,opt,name=major_axis,json=majorAxis,proto3,oneof" json:"major_axis,omitempty"`
+	MinorAxis     *float64 `protobuf:"fixed64,8,opt,name=minor_axis,json=minorAxis,proto3,oneof" json:"minor_axis,omitempty"`
+	Sides         *int32   `protobuf:"varint,9,opt,name=sides,proto3,oneof" json:"sides,omitempty"`
+	CornerRadius  *float64 `protobuf:"fixed64,10,opt,name=corner_radius,json=cornerRadius,proto3,oneof" json:"corner_radius,omitempty"`
+	Vertices      []*Point `protobuf:"bytes,11,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Unit          string   `protobuf:"bytes,12,opt,name=unit,proto3" json:"unit,omitempty"`
+	DiameterValue *float64 `protobuf:"fixed64,13,opt,name=diameter_value,json=diameterValue,proto3,oneof" json:"diameter_value,omitempty"`
+	EdgeValue     *float64 `protobuf:"fixed64,14,opt,name=edge_value,json=edgeValue,proto3,oneof" json:"edge_value,omitempty"`
+	WidthValue    *float64 `protobuf:"fixed64,15,opt,name=width_value,json=widthValue,proto3,oneof" json:"width_value,omitempty"`
+	LengthValue   *float64 `protobuf:"fixed64,16,opt,name=length_value,json=lengthValue,proto3,oneof" json:"length_value,omitempty"`
+}
+
+func (x *Measures) Reset() {
+	*x = Measures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Measures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measures) ProtoMessage() {}
+
+func (x *Measures) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Measures.ProtoReflect.Descriptor instead.
+func (*Measures) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Measures) GetDiameter() int32 {
+	if x != nil && x.Diameter != nil {
+		return *x.Diameter
+	}
+	return 0
+}
+
+func (x *Measures) GetEdge() int32 {
+	if x != nil && x.Edge != nil {
+		return *x.Edge
+	}
+	return 0
+}
+
+func (x *Measures) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *Measures) GetLength() int32 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+func (x *Measures) GetHeight() float64 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *Measures) GetWallCoverage() float64 {
+	if x != nil && x.WallCoverage != nil {
+		return *x.WallCoverage
+	}
+	return 0
+}
+
+func (x *Measures) GetMajorAxis() float64 {
+	if x != nil && x.MajorAxis != nil {
+		return *x.MajorAxis
+	}
+	return 0
+}
+
+func (x *Measures) GetMinorAxis() float64 {
+	if x != nil && x.MinorAxis != nil {
+		return *x.MinorAxis
+	}
+	return 0
+}
+
+func (x *Measures) GetSides() int32 {
+	if x != nil && x.Sides != nil {
+		return *x.Sides
+	}
+	return 0
+}
+
+func (x *Measures) GetCornerRadius() float64 {
+	if x != nil && x.CornerRadius != nil {
+		return *x.CornerRadius
+	}
+	return 0
+}
+
+func (x *Measures) GetVertices() []*Point {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+func (x *Measures) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Measures) GetDiameterValue() float64 {
+	if x != nil && x.DiameterValue != nil {
+		return *x.DiameterValue
+	}
+	return 0
+}
+
+func (x *Measures) GetEdgeValue() float64 {
+	if x != nil && x.EdgeValue != nil {
+		return *x.EdgeValue
+	}
+	return 0
+}
+
+func (x *Measures) GetWidthValue() float64 {
+	if x != nil && x.WidthValue != nil {
+		return *x.WidthValue
+	}
+	return 0
+}
+
+func (x *Measures) GetLengthValue() float64 {
+	if x != nil && x.LengthValue != nil {
+		return *x.LengthValue
+	}
+	return 0
+}
+
+type Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Point) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Point) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Pan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shape               string          `protobuf:"bytes,1,opt,name=shape,proto3" json:"shape,omitempty"`
+	Measures            *Measures       `protobuf:"bytes,2,opt,name=measures,proto3" json:"measures,omitempty"`
+	Name                string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Area                float64         `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	Slices              int32           `protobuf:"varint,5,opt,name=slices,proto3" json:"slices,omitempty"`
+	ToppingRecipe       string          `protobuf:"bytes,6,opt,name=topping_recipe,json=toppingRecipe,proto3" json:"topping_recipe,omitempty"`
+	Sections            []*PanSection   `protobuf:"bytes,7,rep,name=sections,proto3" json:"sections,omitempty"`
+	RimWidth            float64         `protobuf:"fixed64,8,opt,name=rim_width,json=rimWidth,proto3" json:"rim_width,omitempty"`
+	Deep                bool            `protobuf:"varint,9,opt,name=deep,proto3" json:"deep,omitempty"`
+	TopCrustShare       float64         `protobuf:"fixed64,10,opt,name=top_crust_share,json=topCrustShare,proto3" json:"top_crust_share,omitempty"`
+	Material            string          `protobuf:"bytes,11,opt,name=material,proto3" json:"material,omitempty"`
+	Style               string          `protobuf:"bytes,12,opt,name=style,proto3" json:"style,omitempty"`
+	Profile             string          `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	ThicknessMultiplier float64         `protobuf:"fixed64,14,opt,name=thickness_multiplier,json=thicknessMultiplier,proto3" json:"thickness_multiplier,omitempty"`
+	Slicing             *SlicingPattern `protobuf:"bytes,15,opt,name=slicing,proto3" json:"slicing,omitempty"`
+}
+
+func (x *Pan) Reset() {
+	*x = Pan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pan) ProtoMessage() {}
+
+func (x *Pan) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pan.ProtoReflect.Descriptor instead.
+func (*Pan) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Pan) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *Pan) GetMeasures() *Measures {
+	if x != nil {
+		return x.Measures
+	}
+	return nil
+}
+
+func (x *Pan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pan) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Pan) GetSlices() int32 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *Pan) GetToppingRecipe() string {
+	if x != nil {
+		return x.ToppingRecipe
+	}
+	return ""
+}
+
+func (x *Pan) GetSections() []*PanSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Pan) GetRimWidth() float64 {
+	if x != nil {
+		return x.RimWidth
+	}
+	return 0
+}
+
+func (x *Pan) GetDeep() bool {
+	if x != nil {
+		return x.Deep
+	}
+	return false
+}
+
+func (x *Pan) GetTopCrustShare() float64 {
+	if x != nil {
+		return x.TopCrustShare
+	}
+	return 0
+}
+
+func (x *Pan) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *Pan) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *Pan) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *Pan) GetThicknessMultiplier() float64 {
+	if x != nil {
+		return x.ThicknessMultiplier
+	}
+	return 0
+}
+
+func (x *Pan) GetSlicing() *SlicingPattern {
+	if x != nil {
+		return x.Slicing
+	}
+	return nil
+}
+
+type PanProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Material      string        `protobuf:"bytes,2,opt,name=material,proto3" json:"material,omitempty"`
+	Style         string        `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"`
+	ReferenceArea float64       `protobuf:"fixed64,4,opt,name=reference_area,json=referenceArea,proto3" json:"reference_area,omitempty"`
+	Ingredients   []*Ingredient `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+}
+
+func (x *PanProfile) Reset() {
+	*x = PanProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanProfile) ProtoMessage() {}
+
+func (x *PanProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanProfile.ProtoReflect.Descriptor instead.
+func (*PanProfile) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PanProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanProfile) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *PanProfile) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *PanProfile) GetReferenceArea() float64 {
+	if x != nil {
+		return x.ReferenceArea
+	}
+	return 0
+}
+
+func (x *PanProfile) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type PanPreparation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Profile     string        `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Ingredients []*Ingredient `protobuf:"bytes,3,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+}
+
+func (x *PanPreparation) Reset() {
+	*x = PanPreparation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanPreparation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanPreparation) ProtoMessage() {}
+
+func (x *PanPreparation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanPreparation.ProtoReflect.Descriptor instead.
+func (*PanPreparation) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PanPreparation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanPreparation) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *PanPreparation) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type SlicingPattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Rows    int32  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns int32  `protobuf:"varint,3,opt,name=columns,proto3" json:"columns,omitempty"`
+	Slices  int32  `protobuf:"varint,4,opt,name=slices,proto3" json:"slices,omitempty"`
+}
+
+func (x *SlicingPattern) Reset() {
+	*x = SlicingPattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlicingPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlicingPattern) ProtoMessage() {}
+
+func (x *SlicingPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlicingPattern.ProtoReflect.Descriptor instead.
+func (*SlicingPattern) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SlicingPattern) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SlicingPattern) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SlicingPattern) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *SlicingPattern) GetSlices() int32 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+type PanSlices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count   int32         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Weight  float64       `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dough   []*Ingredient `protobuf:"bytes,4,rep,name=dough,proto3" json:"dough,omitempty"`
+	Topping []*Ingredient `protobuf:"bytes,5,rep,name=topping,proto3" json:"topping,omitempty"`
+}
+
+func (x *PanSlices) Reset() {
+	*x = PanSlices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanSlices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanSlices) ProtoMessage() {}
+
+func (x *PanSlices) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanSlices.ProtoReflect.Descriptor instead.
+func (*PanSlices) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PanSlices) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanSlices) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PanSlices) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PanSlices) GetDough() []*Ingredient {
+	if x != nil {
+		return x.Dough
+	}
+	return nil
+}
+
+func (x *PanSlices) GetTopping() []*Ingredient {
+	if x != nil {
+		return x.Topping
+	}
+	return nil
+}
+
+type PanSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fraction      float64 `protobuf:"fixed64,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	ToppingRecipe string  `protobuf:"bytes,3,opt,name=topping_recipe,json=toppingRecipe,proto3" json:"topping_recipe,omitempty"`
+}
+
+func (x *PanSection) Reset() {
+	*x = PanSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanSection) ProtoMessage() {}
+
+func (x *PanSection) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanSection.ProtoReflect.Descriptor instead.
+func (*PanSection) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PanSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanSection) GetFraction() float64 {
+	if x != nil {
+		return x.Fraction
+	}
+	return 0
+}
+
+func (x *PanSection) GetToppingRecipe() string {
+	if x != nil {
+		return x.ToppingRecipe
+	}
+	return ""
+}
+
+type Pans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pans             []*Pan             `protobuf:"bytes,1,rep,name=pans,proto3" json:"pans,omitempty"`
+	TotalArea        float64            `protobuf:"fixed64,2,opt,name=total_area,json=totalArea,proto3" json:"total_area,omitempty"`
+	DefaultRimWidths map[string]float64 `protobuf:"bytes,3,rep,name=default_rim_widths,json=defaultRimWidths,proto3" json:"default_rim_widths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Profiles         []*PanProfile      `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *Pans) Reset() {
+	*x = Pans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pans) ProtoMessage() {}
+
+func (x *Pans) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pans.ProtoReflect.Descriptor instead.
+func (*Pans) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Pans) GetPans() []*Pan {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+func (x *Pans) GetTotalArea() float64 {
+	if x != nil {
+		return x.TotalArea
+	}
+	return 0
+}
+
+func (x *Pans) GetDefaultRimWidths() map[string]float64 {
+	if x != nil {
+		return x.DefaultRimWidths
+	}
+	return nil
+}
+
+func (x *Pans) GetProfiles() []*PanProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SplitIngredients struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SplitDough          []*Dough            `protobuf:"bytes,1,rep,name=split_dough,json=splitDough,proto3" json:"split_dough,omitempty"`
+	SplitTopping        []*Topping          `protobuf:"bytes,2,rep,name=split_topping,json=splitTopping,proto3" json:"split_topping,omitempty"`
+	SplitToppingLayers  []*PanToppingLayers `protobuf:"bytes,3,rep,name=split_topping_layers,json=splitToppingLayers,proto3" json:"split_topping_layers,omitempty"`
+	SplitTopCrust       []*Dough            `protobuf:"bytes,4,rep,name=split_top_crust,json=splitTopCrust,proto3" json:"split_top_crust,omitempty"`
+	SplitPanPreparation []*PanPreparation   `protobuf:"bytes,5,rep,name=split_pan_preparation,json=splitPanPreparation,proto3" json:"split_pan_preparation,omitempty"`
+	SplitSlices         []*PanSlices        `protobuf:"bytes,6,rep,name=split_slices,json=splitSlices,proto3" json:"split_slices,omitempty"`
+}
+
+func (x *SplitIngredients) Reset() {
+	*x = SplitIngredients{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitIngredients) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitIngredients) ProtoMessage() {}
+
+func (x *SplitIngredients) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitIngredients.ProtoReflect.Descriptor instead.
+func (*SplitIngredients) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SplitIngredients) GetSplitDough() []*Dough {
+	if x != nil {
+		return x.SplitDough
+	}
+	return nil
+}
+
+func (x *SplitIngredients) GetSplitTopping() []*Topping {
+	if x != nil {
+		return x.SplitTopping
+	}
+	return nil
+}
+
+func (x *SplitIngredients) GetSplitToppingLayers() []*PanToppingLayers {
+	if x != nil {
+		return x.SplitToppingLayers
+	}
+	return nil
+}
+
+func (x *SplitIngredients) GetSplitTopCrust() []*Dough {
+	if x != nil {
+		return x.SplitTopCrust
+	}
+	return nil
+}
+
+func (x *SplitIngredients) GetSplitPanPreparation() []*PanPreparation {
+	if x != nil {
+		return x.SplitPanPreparation
+	}
+	return nil
+}
+
+func (x *SplitIngredients) GetSplitSlices() []*PanSlices {
+	if x != nil {
+		return x.SplitSlices
+	}
+	return nil
+}
+
+type MixerProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxDoughWeight float64 `protobuf:"fixed64,1,opt,name=max_dough_weight,json=maxDoughWeight,proto3" json:"max_dough_weight,omitempty"`
+	MinDoughWeight float64 `protobuf:"fixed64,2,opt,name=min_dough_weight,json=minDoughWeight,proto3" json:"min_dough_weight,omitempty"`
+	BowlCount      int32   `protobuf:"varint,3,opt,name=bowl_count,json=bowlCount,proto3" json:"bowl_count,omitempty"`
+}
+
+func (x *MixerProfile) Reset() {
+	*x = MixerProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MixerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MixerProfile) ProtoMessage() {}
+
+func (x *MixerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MixerProfile.ProtoReflect.Descriptor instead.
+func (*MixerProfile) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MixerProfile) GetMaxDoughWeight() float64 {
+	if x != nil {
+		return x.MaxDoughWeight
+	}
+	return 0
+}
+
+func (x *MixerProfile) GetMinDoughWeight() float64 {
+	if x != nil {
+		return x.MinDoughWeight
+	}
+	return 0
+}
+
+func (x *MixerProfile) GetBowlCount() int32 {
+	if x != nil {
+		return x.BowlCount
+	}
+	return 0
+}
+
+type PanPortion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DoughWeight float64 `protobuf:"fixed64,2,opt,name=dough_weight,json=doughWeight,proto3" json:"dough_weight,omitempty"`
+}
+
+func (x *PanPortion) Reset() {
+	*x = PanPortion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanPortion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanPortion) ProtoMessage() {}
+
+func (x *PanPortion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanPortion.ProtoReflect.Descriptor instead.
+func (*PanPortion) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PanPortion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanPortion) GetDoughWeight() float64 {
+	if x != nil {
+		return x.DoughWeight
+	}
+	return 0
+}
+
+type MixingBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number      int32         `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Bowl        int32         `protobuf:"varint,2,opt,name=bowl,proto3" json:"bowl,omitempty"`
+	DoughWeight float64       `protobuf:"fixed64,3,opt,name=dough_weight,json=doughWeight,proto3" json:"dough_weight,omitempty"`
+	Ingredients []*Ingredient `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Pans        []*PanPortion `protobuf:"bytes,5,rep,name=pans,proto3" json:"pans,omitempty"`
+}
+
+func (x *MixingBatch) Reset() {
+	*x = MixingBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MixingBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MixingBatch) ProtoMessage() {}
+
+func (x *MixingBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MixingBatch.ProtoReflect.Descriptor instead.
+func (*MixingBatch) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MixingBatch) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MixingBatch) GetBowl() int32 {
+	if x != nil {
+		return x.Bowl
+	}
+	return 0
+}
+
+func (x *MixingBatch) GetDoughWeight() float64 {
+	if x != nil {
+		return x.DoughWeight
+	}
+	return 0
+}
+
+func (x *MixingBatch) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *MixingBatch) GetPans() []*PanPortion {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+type DoughBallGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count           int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	BallWeight      float64 `protobuf:"fixed64,3,opt,name=ball_weight,json=ballWeight,proto3" json:"ball_weight,omitempty"`
+	Diameter        float64 `protobuf:"fixed64,4,opt,name=diameter,proto3" json:"diameter,omitempty"`
+	ThicknessFactor float64 `protobuf:"fixed64,5,opt,name=thickness_factor,json=thicknessFactor,proto3" json:"thickness_factor,omitempty"`
+	ToppingRecipe   string  `protobuf:"bytes,6,opt,name=topping_recipe,json=toppingRecipe,proto3" json:"topping_recipe,omitempty"`
+}
+
+func (x *DoughBallGroup) Reset() {
+	*x = DoughBallGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoughBallGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoughBallGroup) ProtoMessage() {}
+
+func (x *DoughBallGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoughBallGroup.ProtoReflect.Descriptor instead.
+func (*DoughBallGroup) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DoughBallGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DoughBallGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DoughBallGroup) GetBallWeight() float64 {
+	if x != nil {
+		return x.BallWeight
+	}
+	return 0
+}
+
+func (x *DoughBallGroup) GetDiameter() float64 {
+	if x != nil {
+		return x.Diameter
+	}
+	return 0
+}
+
+func (x *DoughBallGroup) GetThicknessFactor() float64 {
+	if x != nil {
+		return x.ThicknessFactor
+	}
+	return 0
+}
+
+func (x *DoughBallGroup) GetToppingRecipe() string {
+	if x != nil {
+		return x.ToppingRecipe
+	}
+	return ""
+}
+
+type DoughBallPortion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count       int32         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	BallWeight  float64       `protobuf:"fixed64,3,opt,name=ball_weight,json=ballWeight,proto3" json:"ball_weight,omitempty"`
+	Ingredients []*Ingredient `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+}
+
+func (x *DoughBallPortion) Reset() {
+	*x = DoughBallPortion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoughBallPortion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoughBallPortion) ProtoMessage() {}
+
+func (x *DoughBallPortion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoughBallPortion.ProtoReflect.Descriptor instead.
+func (*DoughBallPortion) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DoughBallPortion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DoughBallPortion) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DoughBallPortion) GetBallWeight() float64 {
+	if x != nil {
+		return x.BallWeight
+	}
+	return 0
+}
+
+func (x *DoughBallPortion) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type PrefermentSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferment *Dough `protobuf:"bytes,1,opt,name=preferment,proto3" json:"preferment,omitempty"`
+	FinalDough *Dough `protobuf:"bytes,2,opt,name=final_dough,json=finalDough,proto3" json:"final_dough,omitempty"`
+}
+
+func (x *PrefermentSplit) Reset() {
+	*x = PrefermentSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefermentSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefermentSplit) ProtoMessage() {}
+
+func (x *PrefermentSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefermentSplit.ProtoReflect.Descriptor instead.
+func (*PrefermentSplit) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PrefermentSplit) GetPreferment() *Dough {
+	if x != nil {
+		return x.Preferment
+	}
+	return nil
+}
+
+func (x *PrefermentSplit) GetFinalDough() *Dough {
+	if x != nil {
+		return x.FinalDough
+	}
+	return nil
+}
+
+type RecipeAggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe             *Recipe             `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	SplitIngredients   *SplitIngredients   `protobuf:"bytes,2,opt,name=split_ingredients,json=splitIngredients,proto3" json:"split_ingredients,omitempty"`
+	MixingBatches      []*MixingBatch      `protobuf:"bytes,3,rep,name=mixing_batches,json=mixingBatches,proto3" json:"mixing_batches,omitempty"`
+	DoughBalls         []*DoughBallPortion `protobuf:"bytes,4,rep,name=dough_balls,json=doughBalls,proto3" json:"dough_balls,omitempty"`
+	SurplusDough       *Dough              `protobuf:"bytes,5,opt,name=surplus_dough,json=surplusDough,proto3" json:"surplus_dough,omitempty"`
+	PrefermentSplit    *PrefermentSplit    `protobuf:"bytes,6,opt,name=preferment_split,json=prefermentSplit,proto3" json:"preferment_split,omitempty"`
+	FormulaPercentages []*Ingredient       `protobuf:"bytes,7,rep,name=formula_percentages,json=formulaPercentages,proto3" json:"formula_percentages,omitempty"`
+	EffectiveHydration float64             `protobuf:"fixed64,8,opt,name=effective_hydration,json=effectiveHydration,proto3" json:"effective_hydration,omitempty"`
+	Warnings           []string            `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	SolvedTargets      *SolvedTargets      `protobuf:"bytes,10,opt,name=solved_targets,json=solvedTargets,proto3" json:"solved_targets,omitempty"`
+	Leavening          *Leavening          `protobuf:"bytes,11,opt,name=leavening,proto3" json:"leavening,omitempty"`
+	Notes              []string            `protobuf:"bytes,12,rep,name=notes,proto3" json:"notes,omitempty"`
+	AppliedRules       []*RuleApplication  `protobuf:"bytes,13,rep,name=applied_rules,json=appliedRules,proto3" json:"applied_rules,omitempty"`
+	ToppingGroups      []*ToppingGroup     `protobuf:"bytes,14,rep,name=topping_groups,json=toppingGroups,proto3" json:"topping_groups,omitempty"`
+	ToppingArea        float64             `protobuf:"fixed64,15,opt,name=topping_area,json=toppingArea,proto3" json:"topping_area,omitempty"`
+	PanPreparation     []*Ingredient       `protobuf:"bytes,16,rep,name=pan_preparation,json=panPreparation,proto3" json:"pan_preparation,omitempty"`
+	DoughToMix         *Dough              `protobuf:"bytes,17,opt,name=dough_to_mix,json=doughToMix,proto3" json:"dough_to_mix,omitempty"`
+	ProcessLossWeight  float64             `protobuf:"fixed64,18,opt,name=process_loss_weight,json=processLossWeight,proto3" json:"process_loss_weight,omitempty"`
+}
+
+func (x *RecipeAggregate) Reset() {
+	*x = RecipeAggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecipeAggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeAggregate) ProtoMessage() {}
+
+func (x *RecipeAggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeAggregate.ProtoReflect.Descriptor instead.
+func (*RecipeAggregate) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecipeAggregate) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetSplitIngredients() *SplitIngredients {
+	if x != nil {
+		return x.SplitIngredients
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetMixingBatches() []*MixingBatch {
+	if x != nil {
+		return x.MixingBatches
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetDoughBalls() []*DoughBallPortion {
+	if x != nil {
+		return x.DoughBalls
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetSurplusDough() *Dough {
+	if x != nil {
+		return x.SurplusDough
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetPrefermentSplit() *PrefermentSplit {
+	if x != nil {
+		return x.PrefermentSplit
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetFormulaPercentages() []*Ingredient {
+	if x != nil {
+		return x.FormulaPercentages
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetEffectiveHydration() float64 {
+	if x != nil {
+		return x.EffectiveHydration
+	}
+	return 0
+}
+
+func (x *RecipeAggregate) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetSolvedTargets() *SolvedTargets {
+	if x != nil {
+		return x.SolvedTargets
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetLeavening() *Leavening {
+	if x != nil {
+		return x.Leavening
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetAppliedRules() []*RuleApplication {
+	if x != nil {
+		return x.AppliedRules
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetToppingGroups() []*ToppingGroup {
+	if x != nil {
+		return x.ToppingGroups
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetToppingArea() float64 {
+	if x != nil {
+		return x.ToppingArea
+	}
+	return 0
+}
+
+func (x *RecipeAggregate) GetPanPreparation() []*Ingredient {
+	if x != nil {
+		return x.PanPreparation
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetDoughToMix() *Dough {
+	if x != nil {
+		return x.DoughToMix
+	}
+	return nil
+}
+
+func (x *RecipeAggregate) GetProcessLossWeight() float64 {
+	if x != nil {
+		return x.ProcessLossWeight
+	}
+	return 0
+}
+
+type BalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe            *Recipe                   `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Pans              *Pans                     `protobuf:"bytes,2,opt,name=pans,proto3" json:"pans,omitempty"`
+	Mixer             *MixerProfile             `protobuf:"bytes,3,opt,name=mixer,proto3" json:"mixer,omitempty"`
+	DoughBalls        []*DoughBallGroup         `protobuf:"bytes,4,rep,name=dough_balls,json=doughBalls,proto3" json:"dough_balls,omitempty"`
+	Temperatures      *DoughTemperatureReadings `protobuf:"bytes,5,opt,name=temperatures,proto3" json:"temperatures,omitempty"`
+	YeastSubstitution *YeastSubstitution        `protobuf:"bytes,6,opt,name=yeast_substitution,json=yeastSubstitution,proto3" json:"yeast_substitution,omitempty"`
+	Tenant            string                    `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	RuleSets          []string                  `protobuf:"bytes,8,rep,name=rule_sets,json=ruleSets,proto3" json:"rule_sets,omitempty"`
+}
+
+func (x *BalanceRequest) Reset() {
+	*x = BalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceRequest) ProtoMessage() {}
+
+func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BalanceRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetPans() *Pans {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetMixer() *MixerProfile {
+	if x != nil {
+		return x.Mixer
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetDoughBalls() []*DoughBallGroup {
+	if x != nil {
+		return x.DoughBalls
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetTemperatures() *DoughTemperatureReadings {
+	if x != nil {
+		return x.Temperatures
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetYeastSubstitution() *YeastSubstitution {
+	if x != nil {
+		return x.YeastSubstitution
+	}
+	return nil
+}
+
+func (x *BalanceRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *BalanceRequest) GetRuleSets() []string {
+	if x != nil {
+		return x.RuleSets
+	}
+	return nil
+}
+
+type RuleApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleSet           string  `protobuf:"bytes,1,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`
+	Rule              string  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Section           string  `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	Ingredient        string  `protobuf:"bytes,4,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	Replacement       string  `protobuf:"bytes,5,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	ReplacedAmount    float64 `protobuf:"fixed64,6,opt,name=replaced_amount,json=replacedAmount,proto3" json:"replaced_amount,omitempty"`
+	ReplacementAmount float64 `protobuf:"fixed64,7,opt,name=replacement_amount,json=replacementAmount,proto3" json:"replacement_amount,omitempty"`
+}
+
+func (x *RuleApplication) Reset() {
+	*x = RuleApplication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleApplication) ProtoMessage() {}
+
+func (x *RuleApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleApplication.ProtoReflect.Descriptor instead.
+func (*RuleApplication) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RuleApplication) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetReplacedAmount() float64 {
+	if x != nil {
+		return x.ReplacedAmount
+	}
+	return 0
+}
+
+func (x *RuleApplication) GetReplacementAmount() float64 {
+	if x != nil {
+		return x.ReplacementAmount
+	}
+	return 0
+}
+
+type YeastSubstitution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target           string             `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Factors          map[string]float64 `protobuf:"bytes,2,rep,name=factors,proto3" json:"factors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	StarterHydration float64            `protobuf:"fixed64,3,opt,name=starter_hydration,json=starterHydration,proto3" json:"starter_hydration,omitempty"`
+}
+
+func (x *YeastSubstitution) Reset() {
+	*x = YeastSubstitution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YeastSubstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YeastSubstitution) ProtoMessage() {}
+
+func (x *YeastSubstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YeastSubstitution.ProtoReflect.Descriptor instead.
+func (*YeastSubstitution) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *YeastSubstitution) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *YeastSubstitution) GetFactors() map[string]float64 {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+func (x *YeastSubstitution) GetStarterHydration() float64 {
+	if x != nil {
+		return x.StarterHydration
+	}
+	return 0
+}
+
+type BalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipeAggregate *RecipeAggregate `protobuf:"bytes,1,opt,name=recipe_aggregate,json=recipeAggregate,proto3" json:"recipe_aggregate,omitempty"`
+}
+
+func (x *BalanceResponse) Reset() {
+	*x = BalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceResponse) ProtoMessage() {}
+
+func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BalanceResponse) GetRecipeAggregate() *RecipeAggregate {
+	if x != nil {
+		return x.RecipeAggregate
+	}
+	return nil
+}
+
+type PanCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pan         *Pan   `protobuf:"bytes,1,opt,name=pan,proto3" json:"pan,omitempty"`
+	MaxQuantity *int32 `protobuf:"varint,2,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
+}
+
+func (x *PanCandidate) Reset() {
+	*x = PanCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanCandidate) ProtoMessage() {}
+
+func (x *PanCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanCandidate.ProtoReflect.Descriptor instead.
+func (*PanCandidate) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PanCandidate) GetPan() *Pan {
+	if x != nil {
+		return x.Pan
+	}
+	return nil
+}
+
+func (x *PanCandidate) GetMaxQuantity() int32 {
+	if x != nil && x.MaxQuantity != nil {
+		return *x.MaxQuantity
+	}
+	return 0
+}
+
+type PanSelection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pan      *Pan  `protobuf:"bytes,1,opt,name=pan,proto3" json:"pan,omitempty"`
+	Quantity int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *PanSelection) Reset() {
+	*x = PanSelection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanSelection) ProtoMessage() {}
+
+func (x *PanSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanSelection.ProtoReflect.Descriptor instead.
+func (*PanSelection) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PanSelection) GetPan() *Pan {
+	if x != nil {
+		return x.Pan
+	}
+	return nil
+}
+
+func (x *PanSelection) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReverseBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe             *Recipe         `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Candidates         []*PanCandidate `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	LimitingIngredient *Ingredient     `protobuf:"bytes,3,opt,name=limiting_ingredient,json=limitingIngredient,proto3" json:"limiting_ingredient,omitempty"`
+}
+
+func (x *ReverseBalanceRequest) Reset() {
+	*x = ReverseBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseBalanceRequest) ProtoMessage() {}
+
+func (x *ReverseBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseBalanceRequest.ProtoReflect.Descriptor instead.
+func (*ReverseBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReverseBalanceRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *ReverseBalanceRequest) GetCandidates() []*PanCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ReverseBalanceRequest) GetLimitingIngredient() *Ingredient {
+	if x != nil {
+		return x.LimitingIngredient
+	}
+	return nil
+}
+
+type ReverseBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selections      []*PanSelection  `protobuf:"bytes,1,rep,name=selections,proto3" json:"selections,omitempty"`
+	RecipeAggregate *RecipeAggregate `protobuf:"bytes,2,opt,name=recipe_aggregate,json=recipeAggregate,proto3" json:"recipe_aggregate,omitempty"`
+	UsedAmount      float64          `protobuf:"fixed64,3,opt,name=used_amount,json=usedAmount,proto3" json:"used_amount,omitempty"`
+	LeftoverAmount  float64          `protobuf:"fixed64,4,opt,name=leftover_amount,json=leftoverAmount,proto3" json:"leftover_amount,omitempty"`
+}
+
+func (x *ReverseBalanceResponse) Reset() {
+	*x = ReverseBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseBalanceResponse) ProtoMessage() {}
+
+func (x *ReverseBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseBalanceResponse.ProtoReflect.Descriptor instead.
+func (*ReverseBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReverseBalanceResponse) GetSelections() []*PanSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+func (x *ReverseBalanceResponse) GetRecipeAggregate() *RecipeAggregate {
+	if x != nil {
+		return x.RecipeAggregate
+	}
+	return nil
+}
+
+func (x *ReverseBalanceResponse) GetUsedAmount() float64 {
+	if x != nil {
+		return x.UsedAmount
+	}
+	return 0
+}
+
+func (x *ReverseBalanceResponse) GetLeftoverAmount() float64 {
+	if x != nil {
+		return x.LeftoverAmount
+	}
+	return 0
+}
+
+type ServingsTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servings       int32            `protobuf:"varint,1,opt,name=servings,proto3" json:"servings,omitempty"`
+	SlicesPerShape map[string]int32 `protobuf:"bytes,2,rep,name=slices_per_shape,json=slicesPerShape,proto3" json:"slices_per_shape,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ServingsTarget) Reset() {
+	*x = ServingsTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServingsTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServingsTarget) ProtoMessage() {}
+
+func (x *ServingsTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServingsTarget.ProtoReflect.Descriptor instead.
+func (*ServingsTarget) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ServingsTarget) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *ServingsTarget) GetSlicesPerShape() map[string]int32 {
+	if x != nil {
+		return x.SlicesPerShape
+	}
+	return nil
+}
+
+type PanAssortment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selections    []*PanSelection `protobuf:"bytes,1,rep,name=selections,proto3" json:"selections,omitempty"`
+	Servings      int32           `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`
+	LeftoverDough float64         `protobuf:"fixed64,3,opt,name=leftover_dough,json=leftoverDough,proto3" json:"leftover_dough,omitempty"`
+	Utilization   float64         `protobuf:"fixed64,4,opt,name=utilization,proto3" json:"utilization,omitempty"`
+}
+
+func (x *PanAssortment) Reset() {
+	*x = PanAssortment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanAssortment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanAssortment) ProtoMessage() {}
+
+func (x *PanAssortment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanAssortment.ProtoReflect.Descriptor instead.
+func (*PanAssortment) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PanAssortment) GetSelections() []*PanSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+func (x *PanAssortment) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *PanAssortment) GetLeftoverDough() float64 {
+	if x != nil {
+		return x.LeftoverDough
+	}
+	return 0
+}
+
+func (x *PanAssortment) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+type OptimizePansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe  *Recipe         `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Catalog []*PanCandidate `protobuf:"bytes,2,rep,name=catalog,proto3" json:"catalog,omitempty"`
+	Target  *ServingsTarget `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *OptimizePansRequest) Reset() {
+	*x = OptimizePansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizePansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizePansRequest) ProtoMessage() {}
+
+func (x *OptimizePansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizePansRequest.ProtoReflect.Descriptor instead.
+func (*OptimizePansRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OptimizePansRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *OptimizePansRequest) GetCatalog() []*PanCandidate {
+	if x != nil {
+		return x.Catalog
+	}
+	return nil
+}
+
+func (x *OptimizePansRequest) GetTarget() *ServingsTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type OptimizePansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance    *BalanceResponse `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Assortment *PanAssortment   `protobuf:"bytes,2,opt,name=assortment,proto3" json:"assortment,omitempty"`
+}
+
+func (x *OptimizePansResponse) Reset() {
+	*x = OptimizePansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizePansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizePansResponse) ProtoMessage() {}
+
+func (x *OptimizePansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizePansResponse.ProtoReflect.Descriptor instead.
+func (*OptimizePansResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OptimizePansResponse) GetBalance() *BalanceResponse {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *OptimizePansResponse) GetAssortment() *PanAssortment {
+	if x != nil {
+		return x.Assortment
+	}
+	return nil
+}
+
+type PackageSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *PackageSize) Reset() {
+	*x = PackageSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageSize) ProtoMessage() {}
+
+func (x *PackageSize) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageSize.ProtoReflect.Descriptor instead.
+func (*PackageSize) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PackageSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageSize) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ShoppingItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequiredAmount float64 `protobuf:"fixed64,2,opt,name=required_amount,json=requiredAmount,proto3" json:"required_amount,omitempty"`
+	StockAmount    float64 `protobuf:"fixed64,3,opt,name=stock_amount,json=stockAmount,proto3" json:"stock_amount,omitempty"`
+	NeededAmount   float64 `protobuf:"fixed64,4,opt,name=needed_amount,json=neededAmount,proto3" json:"needed_amount,omitempty"`
+	PackageSize    float64 `protobuf:"fixed64,5,opt,name=package_size,json=packageSize,proto3" json:"package_size,omitempty"`
+	Packages       int32   `protobuf:"varint,6,opt,name=packages,proto3" json:"packages,omitempty"`
+	PurchaseAmount float64 `protobuf:"fixed64,7,opt,name=purchase_amount,json=purchaseAmount,proto3" json:"purchase_amount,omitempty"`
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetRequiredAmount() float64 {
+	if x != nil {
+		return x.RequiredAmount
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetStockAmount() float64 {
+	if x != nil {
+		return x.StockAmount
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetNeededAmount() float64 {
+	if x != nil {
+		return x.NeededAmount
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetPackageSize() float64 {
+	if x != nil {
+		return x.PackageSize
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetPackages() int32 {
+	if x != nil {
+		return x.Packages
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetPurchaseAmount() float64 {
+	if x != nil {
+		return x.PurchaseAmount
+	}
+	return 0
+}
+
+type ShoppingListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipeAggregates []*RecipeAggregate `protobuf:"bytes,1,rep,name=recipe_aggregates,json=recipeAggregates,proto3" json:"recipe_aggregates,omitempty"`
+	PackageSizes     []*PackageSize     `protobuf:"bytes,2,rep,name=package_sizes,json=packageSizes,proto3" json:"package_sizes,omitempty"`
+	Stock            []*Ingredient      `protobuf:"bytes,3,rep,name=stock,proto3" json:"stock,omitempty"`
+}
+
+func (x *ShoppingListRequest) Reset() {
+	*x = ShoppingListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListRequest) ProtoMessage() {}
+
+func (x *ShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*ShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ShoppingListRequest) GetRecipeAggregates() []*RecipeAggregate {
+	if x != nil {
+		return x.RecipeAggregates
+	}
+	return nil
+}
+
+func (x *ShoppingListRequest) GetPackageSizes() []*PackageSize {
+	if x != nil {
+		return x.PackageSizes
+	}
+	return nil
+}
+
+func (x *ShoppingListRequest) GetStock() []*Ingredient {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+type ShoppingListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ShoppingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Json  string          `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	Csv   string          `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *ShoppingListResponse) Reset() {
+	*x = ShoppingListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListResponse) ProtoMessage() {}
+
+func (x *ShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ShoppingListResponse) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+type ValidateRecipeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+}
+
+func (x *ValidateRecipeRequest) Reset() {
+	*x = ValidateRecipeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecipeRequest) ProtoMessage() {}
+
+func (x *ValidateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ValidateRecipeRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type ValidateRecipeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid  bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Issues []string `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *ValidateRecipeResponse) Reset() {
+	*x = ValidateRecipeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecipeResponse) ProtoMessage() {}
+
+func (x *ValidateRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ValidateRecipeResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateRecipeResponse) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type PanScenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pans *Pans  `protobuf:"bytes,2,opt,name=pans,proto3" json:"pans,omitempty"`
+}
+
+func (x *PanScenario) Reset() {
+	*x = PanScenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanScenario) ProtoMessage() {}
+
+func (x *PanScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanScenario.ProtoReflect.Descriptor instead.
+func (*PanScenario) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PanScenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PanScenario) GetPans() *Pans {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+type IngredientPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price    float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *IngredientPrice) Reset() {
+	*x = IngredientPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngredientPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientPrice) ProtoMessage() {}
+
+func (x *IngredientPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientPrice.ProtoReflect.Descriptor instead.
+func (*IngredientPrice) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *IngredientPrice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngredientPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *IngredientPrice) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ScenarioComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RecipeAggregate     *RecipeAggregate `protobuf:"bytes,2,opt,name=recipe_aggregate,json=recipeAggregate,proto3" json:"recipe_aggregate,omitempty"`
+	TotalDough          float64          `protobuf:"fixed64,3,opt,name=total_dough,json=totalDough,proto3" json:"total_dough,omitempty"`
+	TotalTopping        float64          `protobuf:"fixed64,4,opt,name=total_topping,json=totalTopping,proto3" json:"total_topping,omitempty"`
+	Servings            int32            `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	Leftover            float64          `protobuf:"fixed64,6,opt,name=leftover,proto3" json:"leftover,omitempty"`
+	Utilization         float64          `protobuf:"fixed64,7,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Cost                *float64         `protobuf:"fixed64,8,opt,name=cost,proto3,oneof" json:"cost,omitempty"`
+	UnpricedIngredients []string         `protobuf:"bytes,9,rep,name=unpriced_ingredients,json=unpricedIngredients,proto3" json:"unpriced_ingredients,omitempty"`
+}
+
+func (x *ScenarioComparison) Reset() {
+	*x = ScenarioComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScenarioComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioComparison) ProtoMessage() {}
+
+func (x *ScenarioComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioComparison.ProtoReflect.Descriptor instead.
+func (*ScenarioComparison) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScenarioComparison) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScenarioComparison) GetRecipeAggregate() *RecipeAggregate {
+	if x != nil {
+		return x.RecipeAggregate
+	}
+	return nil
+}
+
+func (x *ScenarioComparison) GetTotalDough() float64 {
+	if x != nil {
+		return x.TotalDough
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetTotalTopping() float64 {
+	if x != nil {
+		return x.TotalTopping
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetLeftover() float64 {
+	if x != nil {
+		return x.Leftover
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetCost() float64 {
+	if x != nil && x.Cost != nil {
+		return *x.Cost
+	}
+	return 0
+}
+
+func (x *ScenarioComparison) GetUnpricedIngredients() []string {
+	if x != nil {
+		return x.UnpricedIngredients
+	}
+	return nil
+}
+
+type CompareScenariosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe    *Recipe            `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Scenarios []*PanScenario     `protobuf:"bytes,2,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	Servings  int32              `protobuf:"varint,3,opt,name=servings,proto3" json:"servings,omitempty"`
+	Prices    []*IngredientPrice `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *CompareScenariosRequest) Reset() {
+	*x = CompareScenariosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareScenariosRequest) ProtoMessage() {}
+
+func (x *CompareScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareScenariosRequest.ProtoReflect.Descriptor instead.
+func (*CompareScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CompareScenariosRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *CompareScenariosRequest) GetScenarios() []*PanScenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *CompareScenariosRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CompareScenariosRequest) GetPrices() []*IngredientPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type CompareScenariosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scenarios []*ScenarioComparison `protobuf:"bytes,1,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+}
+
+func (x *CompareScenariosResponse) Reset() {
+	*x = CompareScenariosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareScenariosResponse) ProtoMessage() {}
+
+func (x *CompareScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareScenariosResponse.ProtoReflect.Descriptor instead.
+func (*CompareScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CompareScenariosResponse) GetScenarios() []*ScenarioComparison {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+var File_pkg_infrastructure_grpc_proto_ingredients_balancer_proto protoreflect.FileDescriptor
+
+var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x22, 0x84, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x79,
+	0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6c, 0x6f, 0x75,
+	0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x79, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x79,
+	0x65, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xef,
+	0x03, 0x0a, 0x05, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x3c, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x46, 0x0a,
+	0x0c, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x72, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x10, 0x77, 0x61, 0x74, 0x65, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x6f, 0x73, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x73, 0x73,
+	0x22, 0x81, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x73, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x78, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x78, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x6f, 0x73, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x18, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x75,
+	0x67, 0x68, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x75,
+	0x67, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x66, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x66, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x70, 0x5f, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x61, 0x70, 0x57, 0x61, 0x74, 0x65, 0x72, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x57, 0x61,
+	0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x63, 0x65, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x61, 0x74, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x65, 0x0a, 0x0c, 0x46, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x79, 0x65, 0x61,
+	0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x79,
+	0x65, 0x61, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x79, 0x65, 0x61, 0x73, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x79, 0x65, 0x61, 0x73,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x79, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x79, 0x65, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x79, 0x65, 0x61, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x73, 0x68, 0x59, 0x65, 0x61, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x79, 0x65, 0x61,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x59, 0x65, 0x61, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x79, 0x5f, 0x79, 0x65,
+	0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x72, 0x79, 0x59, 0x65,
+	0x61, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x0d, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x61, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x22, 0xb8, 0x01,
+	0x0a, 0x07, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x5d, 0x0a, 0x10, 0x50, 0x61, 0x6e, 0x54,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x37, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x59, 0x0a,
+	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x22, 0xa7, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x31, 0x0a,
+	0x05, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x05, 0x64, 0x6f, 0x75, 0x67, 0x68,
+	0x12, 0x37, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x44, 0x0a, 0x0e,
+	0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x22, 0x80, 0x06, 0x0a, 0x08, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x19, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52,
+	0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x06, 0x52, 0x09, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x61, 0x78,
+	0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x6f,
+	0x72, 0x41, 0x78, 0x69, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x64, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x08, 0x52, 0x05, 0x73, 0x69, 0x64, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x72, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x64, 0x69,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x0a, 0x52, 0x0d, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0b, 0x52, 0x09, 0x65, 0x64,
+	0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x0c, 0x52, 0x0a, 0x77, 0x69, 0x64, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0d, 0x52, 0x0b, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x5f, 0x61, 0x78, 0x69, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x72,
+	0x6e, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64,
+	0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a,
+	0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x79, 0x22, 0x94, 0x04, 0x0a, 0x03, 0x50, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65,
+	0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6d, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x69, 0x6d, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x65, 0x65, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65,
+	0x65, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x70,
+	0x43, 0x72, 0x75, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x6c, 0x69,
+	0x63, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x53, 0x6c, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x52, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x22, 0xbd, 0x01, 0x0a, 0x0a, 0x50, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x50, 0x61,
+	0x6e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70,
+	0x0a, 0x0e, 0x53, 0x6c, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0xc1, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x6e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x36, 0x0a, 0x05, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x22, 0x63, 0x0a, 0x0a, 0x50, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x04, 0x50, 0x61,
+	0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x61, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61,
+	0x12, 0x5e, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x69, 0x6d, 0x5f,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x69, 0x6d, 0x57, 0x69, 0x64, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x69, 0x6d, 0x57, 0x69, 0x64, 0x74, 0x68, 0x73,
+	0x12, 0x3c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x43,
+	0x0a, 0x15, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x69, 0x6d, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xd1, 0x03, 0x0a, 0x10, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0a, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x58, 0x0a, 0x14, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x6e, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x52, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x6f,
+	0x70, 0x5f, 0x63, 0x72, 0x75, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0d, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x6f, 0x70, 0x43, 0x72, 0x75, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x15, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x5f, 0x70, 0x61, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x73, 0x6c, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x6e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x4d, 0x69, 0x78, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69,
+	0x6e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6f, 0x77, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x62, 0x6f, 0x77, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x0a, 0x50,
+	0x61, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x78, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x77, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x77, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x50, 0x6f, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x44, 0x6f,
+	0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x6c, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x6c,
+	0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74,
+	0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x42,
+	0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x6c, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x6c, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x3b, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x22, 0xf8, 0x08, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x6d, 0x69, 0x78, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x78, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x0d, 0x6d, 0x69, 0x78, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0b, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x62, 0x61, 0x6c, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f,
+	0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x64, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x73, 0x75,
+	0x72, 0x70, 0x6c, 0x75, 0x73, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0c,
+	0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x50, 0x0a, 0x10,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x0f, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x51,
+	0x0a, 0x13, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4a,
+	0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x0d, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x4a, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x74,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x61, 0x6e,
+	0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x74, 0x6f,
+	0x5f, 0x6d, 0x69, 0x78, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x52, 0x0a, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x54, 0x6f,
+	0x4d, 0x69, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x73, 0x73, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xd8, 0x03, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x05,
+	0x6d, 0x69, 0x78, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x4d, 0x69, 0x78, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x05, 0x6d, 0x69, 0x78, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x5f,
+	0x62, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x0a, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x52, 0x0a,
+	0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x67, 0x68,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x56, 0x0a, 0x12, 0x79, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x59, 0x65, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x79, 0x65, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x73, 0x22, 0xf4,
+	0x01, 0x0a, 0x0f, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x11, 0x59, 0x65, 0x61, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x59, 0x65, 0x61, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x72, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x0f,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x22, 0x74, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x2b, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x52, 0x03, 0x70, 0x61, 0x6e, 0x12, 0x26,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x52,
+	0x03, 0x70, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xe4, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x12, 0x42, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x13, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75,
+	0x73, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x66,
+	0x74, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x62, 0x0a, 0x10, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x2e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x68, 0x61, 0x70, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x70, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x68, 0x61, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x6e,
+	0x41, 0x73, 0x73, 0x6f, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65,
+	0x66, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x6c, 0x65, 0x66, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x6f, 0x75, 0x67,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65,
+	0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12,
+	0x3c, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x9c, 0x01,
+	0x0a, 0x14, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x41, 0x73, 0x73, 0x6f, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x6f, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0b,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6e, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xe9, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x11, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x10, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a,
+	0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x76, 0x0a,
+	0x14, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0x4d, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x0b,
+	0x50, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x22,
+	0x57, 0x0a, 0x0f, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xef, 0x02, 0x0a, 0x12, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x6f, 0x75, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x6f,
+	0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x14, 0x75, 0x6e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x75, 0x6e,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x17, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x69, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x32, 0x9c, 0x05, 0x0a,
+	0x13, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x24, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x6e, 0x73, 0x12, 0x29, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x53, 0x5a, 0x51, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x66, 0x69, 0x6f, 0x72, 0x65,
+	0x74, 0x74, 0x69, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2d,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescOnce sync.Once
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData = file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDesc
+)
+
+func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescGZIP() []byte {
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescOnce.Do(func() {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData)
+	})
+	return file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDescData
+}
+
+var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_goTypes = []interface{}{
+	(*Ingredient)(nil),               // 0: ingredients_balancer.Ingredient
+	(*Preferment)(nil),               // 1: ingredients_balancer.Preferment
+	(*Dough)(nil),                    // 2: ingredients_balancer.Dough
+	(*ProcessLoss)(nil),              // 3: ingredients_balancer.ProcessLoss
+	(*DoughTemperatureReadings)(nil), // 4: ingredients_balancer.DoughTemperatureReadings
+	(*WaterTemperature)(nil),         // 5: ingredients_balancer.WaterTemperature
+	(*Fermentation)(nil),             // 6: ingredients_balancer.Fermentation
+	(*Leavening)(nil),                // 7: ingredients_balancer.Leavening
+	(*DoughTargets)(nil),             // 8: ingredients_balancer.DoughTargets
+	(*SolvedTargets)(nil),            // 9: ingredients_balancer.SolvedTargets
+	(*Topping)(nil),                  // 10: ingredients_balancer.Topping
+	(*PanToppingLayers)(nil),         // 11: ingredients_balancer.PanToppingLayers
+	(*ToppingGroup)(nil),             // 12: ingredients_balancer.ToppingGroup
+	(*Step)(nil),                     // 13: ingredients_balancer.Step
+	(*Steps)(nil),                    // 14: ingredients_balancer.Steps
+	(*Recipe)(nil),                   // 15: ingredients_balancer.Recipe
+	(*Measures)(nil),                 // 16: ingredients_balancer.Measures
+	(*Point)(nil),                    // 17: ingredients_balancer.Point
+	(*Pan)(nil),                      // 18: ingredients_balancer.Pan
+	(*PanProfile)(nil),               // 19: ingredients_balancer.PanProfile
+	(*PanPreparation)(nil),           // 20: ingredients_balancer.PanPreparation
+	(*SlicingPattern)(nil),           // 21: ingredients_balancer.SlicingPattern
+	(*PanSlices)(nil),                // 22: ingredients_balancer.PanSlices
+	(*PanSection)(nil),               // 23: ingredients_balancer.PanSection
+	(*Pans)(nil),                     // 24: ingredients_balancer.Pans
+	(*SplitIngredients)(nil),         // 25: ingredients_balancer.SplitIngredients
+	(*MixerProfile)(nil),             // 26: ingredients_balancer.MixerProfile
+	(*PanPortion)(nil),               // 27: ingredients_balancer.PanPortion
+	(*MixingBatch)(nil),              // 28: ingredients_balancer.MixingBatch
+	(*DoughBallGroup)(nil),           // 29: ingredients_balancer.DoughBallGroup
+	(*DoughBallPortion)(nil),         // 30: ingredients_balancer.DoughBallPortion
+	(*PrefermentSplit)(nil),          // 31: ingredients_balancer.PrefermentSplit
+	(*RecipeAggregate)(nil),          // 32: ingredients_balancer.RecipeAggregate
+	(*BalanceRequest)(nil),           // 33: ingredients_balancer.BalanceRequest
+	(*RuleApplication)(nil),          // 34: ingredients_balancer.RuleApplication
+	(*YeastSubstitution)(nil),        // 35: ingredients_balancer.YeastSubstitution
+	(*BalanceResponse)(nil),          // 36: ingredients_balancer.BalanceResponse
+	(*PanCandidate)(nil),             // 37: ingredients_balancer.PanCandidate
+	(*PanSelection)(nil),             // 38: ingredients_balancer.PanSelection
+	(*ReverseBalanceRequest)(nil),    // 39: ingredients_balancer.ReverseBalanceRequest
+	(*ReverseBalanceResponse)(nil),   // 40: ingredients_balancer.ReverseBalanceResponse
+	(*ServingsTarget)(nil),           // 41: ingredients_balancer.ServingsTarget
+	(*PanAssortment)(nil),            // 42: ingredients_balancer.PanAssortment
+	(*OptimizePansRequest)(nil),      // 43: ingredients_balancer.OptimizePansRequest
+	(*OptimizePansResponse)(nil),     // 44: ingredients_balancer.OptimizePansResponse
+	(*PackageSize)(nil),              // 45: ingredients_balancer.PackageSize
+	(*ShoppingItem)(nil),             // 46: ingredients_balancer.ShoppingItem
+	(*ShoppingListRequest)(nil),      // 47: ingredients_balancer.ShoppingListRequest
+	(*ShoppingListResponse)(nil),     // 48: ingredients_balancer.ShoppingListResponse
+	(*ValidateRecipeRequest)(nil),    // 49: ingredients_balancer.ValidateRecipeRequest
+	(*ValidateRecipeResponse)(nil),   // 50: ingredients_balancer.ValidateRecipeResponse
+	(*PanScenario)(nil),              // 51: ingredients_balancer.PanScenario
+	(*IngredientPrice)(nil),          // 52: ingredients_balancer.IngredientPrice
+	(*ScenarioComparison)(nil),       // 53: ingredients_balancer.ScenarioComparison
+	(*CompareScenariosRequest)(nil),  // 54: ingredients_balancer.CompareScenariosRequest
+	(*CompareScenariosResponse)(nil), // 55: ingredients_balancer.CompareScenariosResponse
+	nil,                              // 56: ingredients_balancer.Pans.DefaultRimWidthsEntry
+	nil,                              // 57: ingredients_balancer.YeastSubstitution.FactorsEntry
+	nil,                              // 58: ingredients_balancer.ServingsTarget.SlicesPerShapeEntry
+}
+var file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_depIdxs = []int32{
+	0,  // 0: ingredients_balancer.Dough.ingredients:type_name -> ingredients_balancer.Ingredient
+	1,  // 1: ingredients_balancer.Dough.preferment:type_name -> ingredients_balancer.Preferment
+	8,  // 2: ingredients_balancer.Dough.targets:type_name -> ingredients_balancer.DoughTargets
+	6,  // 3: ingredients_balancer.Dough.fermentation:type_name -> ingredients_balancer.Fermentation
+	5,  // 4: ingredients_balancer.Dough.water_temperature:type_name -> ingredients_balancer.WaterTemperature
+	3,  // 5: ingredients_balancer.Dough.process_loss:type_name -> ingredients_balancer.ProcessLoss
+	0,  // 6: ingredients_balancer.Topping.ingredients:type_name -> ingredients_balancer.Ingredient
+	10, // 7: ingredients_balancer.PanToppingLayers.layers:type_name -> ingredients_balancer.Topping
+	10, // 8: ingredients_balancer.ToppingGroup.topping:type_name -> ingredients_balancer.Topping
+	13, // 9: ingredients_balancer.Steps.steps:type_name -> ingredients_balancer.Step
+	2,  // 10: ingredients_balancer.Recipe.dough:type_name -> ingredients_balancer.Dough
+	10, // 11: ingredients_balancer.Recipe.topping:type_name -> ingredients_balancer.Topping
+	14, // 12: ingredients_balancer.Recipe.steps:type_name -> ingredients_balancer.Steps
+	10, // 13: ingredients_balancer.Recipe.topping_layers:type_name -> ingredients_balancer.Topping
+	10, // 14: ingredients_balancer.Recipe.topping_recipes:type_name -> ingredients_balancer.Topping
+	17, // 15: ingredients_balancer.Measures.vertices:type_name -> ingredients_balancer.Point
+	16, // 16: ingredients_balancer.Pan.measures:type_name -> ingredients_balancer.Measures
+	23, // 17: ingredients_balancer.Pan.sections:type_name -> ingredients_balancer.PanSection
+	21, // 18: ingredients_balancer.Pan.slicing:type_name -> ingredients_balancer.SlicingPattern
+	0,  // 19: ingredients_balancer.PanProfile.ingredients:type_name -> ingredients_balancer.Ingredient
+	0,  // 20: ingredients_balancer.PanPreparation.ingredients:type_name -> ingredients_balancer.Ingredient
+	0,  // 21: ingredients_balancer.PanSlices.dough:type_name -> ingredients_balancer.Ingredient
+	0,  // 22: ingredients_balancer.PanSlices.topping:type_name -> ingredients_balancer.Ingredient
+	18, // 23: ingredients_balancer.Pans.pans:type_name -> ingredients_balancer.Pan
+	56, // 24: ingredients_balancer.Pans.default_rim_widths:type_name -> ingredients_balancer.Pans.DefaultRimWidthsEntry
+	19, // 25: ingredients_balancer.Pans.profiles:type_name -> ingredients_balancer.PanProfile
+	2,  // 26: ingredients_balancer.SplitIngredients.split_dough:type_name -> ingredients_balancer.Dough
+	10, // 27: ingredients_balancer.SplitIngredients.split_topping:type_name -> ingredients_balancer.Topping
+	11, // 28: ingredients_balancer.SplitIngredients.split_topping_layers:type_name -> ingredients_balancer.PanToppingLayers
+	2,  // 29: ingredients_balancer.SplitIngredients.split_top_crust:type_name -> ingredients_balancer.Dough
+	20, // 30: ingredients_balancer.SplitIngredients.split_pan_preparation:type_name -> ingredients_balancer.PanPreparation
+	22, // 31: ingredients_balancer.SplitIngredients.split_slices:type_name -> ingredients_balancer.PanSlices
+	0,  // 32: ingredients_balancer.MixingBatch.ingredients:type_name -> ingredients_balancer.Ingredient
+	27, // 33: ingredients_balancer.MixingBatch.pans:type_name -> ingredients_balancer.PanPortion
+	0,  // 34: ingredients_balancer.DoughBallPortion.ingredients:type_name -> ingredients_balancer.Ingredient
+	2,  // 35: ingredients_balancer.PrefermentSplit.preferment:type_name -> ingredients_balancer.Dough
+	2,  // 36: ingredients_balancer.PrefermentSplit.final_dough:type_name -> ingredients_balancer.Dough
+	15, // 37: ingredients_balancer.RecipeAggregate.recipe:type_name -> ingredients_balancer.Recipe
+	25, // 38: ingredients_balancer.RecipeAggregate.split_ingredients:type_name -> ingredients_balancer.SplitIngredients
+	28, // 39: ingredients_balancer.RecipeAggregate.mixing_batches:type_name -> ingredients_balancer.MixingBatch
+	30, // 40: ingredients_balancer.RecipeAggregate.dough_balls:type_name -> ingredients_balancer.DoughBallPortion
+	2,  // 41: ingredients_balancer.RecipeAggregate.surplus_dough:type_name -> ingredients_balancer.Dough
+	31, // 42: ingredients_balancer.RecipeAggregate.preferment_split:type_name -> ingredients_balancer.PrefermentSplit
+	0,  // 43: ingredients_balancer.RecipeAggregate.formula_percentages:type_name -> ingredients_balancer.Ingredient
+	9,  // 44: ingredients_balancer.RecipeAggregate.solved_targets:type_name -> ingredients_balancer.SolvedTargets
+	7,  // 45: ingredients_balancer.RecipeAggregate.leavening:type_name -> ingredients_balancer.Leavening
+	34, // 46: ingredients_balancer.RecipeAggregate.applied_rules:type_name -> ingredients_balancer.RuleApplication
+	12, // 47: ingredients_balancer.RecipeAggregate.topping_groups:type_name -> ingredients_balancer.ToppingGroup
+	0,  // 48: ingredients_balancer.RecipeAggregate.pan_preparation:type_name -> ingredients_balancer.Ingredient
+	2,  // 49: ingredients_balancer.RecipeAggregate.dough_to_mix:type_name -> ingredients_balancer.Dough
+	15, // 50: ingredients_balancer.BalanceRequest.recipe:type_name -> ingredients_balancer.Recipe
+	24, // 51: ingredients_balancer.BalanceRequest.pans:type_name -> ingredients_balancer.Pans
+	26, // 52: ingredients_balancer.BalanceRequest.mixer:type_name -> ingredients_balancer.MixerProfile
+	29, // 53: ingredients_balancer.BalanceRequest.dough_balls:type_name -> ingredients_balancer.DoughBallGroup
+	4,  // 54: ingredients_balancer.BalanceRequest.temperatures:type_name -> ingredients_balancer.DoughTemperatureReadings
+	35, // 55: ingredients_balancer.BalanceRequest.yeast_substitution:type_name -> ingredients_balancer.YeastSubstitution
+	57, // 56: ingredients_balancer.YeastSubstitution.factors:type_name -> ingredients_balancer.YeastSubstitution.FactorsEntry
+	32, // 57: ingredients_balancer.BalanceResponse.recipe_aggregate:type_name -> ingredients_balancer.RecipeAggregate
+	18, // 58: ingredients_balancer.PanCandidate.pan:type_name -> ingredients_balancer.Pan
+	18, // 59: ingredients_balancer.PanSelection.pan:type_name -> ingredients_balancer.Pan
+	15, // 60: ingredients_balancer.ReverseBalanceRequest.recipe:type_name -> ingredients_balancer.Recipe
+	37, // 61: ingredients_balancer.ReverseBalanceRequest.candidates:type_name -> ingredients_balancer.PanCandidate
+	0,  // 62: ingredients_balancer.ReverseBalanceRequest.limiting_ingredient:type_name -> ingredients_balancer.Ingredient
+	38, // 63: ingredients_balancer.ReverseBalanceResponse.selections:type_name -> ingredients_balancer.PanSelection
+	32, // 64: ingredients_balancer.ReverseBalanceResponse.recipe_aggregate:type_name -> ingredients_balancer.RecipeAggregate
+	58, // 65: ingredients_balancer.ServingsTarget.slices_per_shape:type_name -> ingredients_balancer.ServingsTarget.SlicesPerShapeEntry
+	38, // 66: ingredients_balancer.PanAssortment.selections:type_name -> ingredients_balancer.PanSelection
+	15, // 67: ingredients_balancer.OptimizePansRequest.recipe:type_name -> ingredients_balancer.Recipe
+	37, // 68: ingredients_balancer.OptimizePansRequest.catalog:type_name -> ingredients_balancer.PanCandidate
+	41, // 69: ingredients_balancer.OptimizePansRequest.target:type_name -> ingredients_balancer.ServingsTarget
+	36, // 70: ingredients_balancer.OptimizePansResponse.balance:type_name -> ingredients_balancer.BalanceResponse
+	42, // 71: ingredients_balancer.OptimizePansResponse.assortment:type_name -> ingredients_balancer.PanAssortment
+	32, // 72: ingredients_balancer.ShoppingListRequest.recipe_aggregates:type_name -> ingredients_balancer.RecipeAggregate
+	45, // 73: ingredients_balancer.ShoppingListRequest.package_sizes:type_name -> ingredients_balancer.PackageSize
+	0,  // 74: ingredients_balancer.ShoppingListRequest.stock:type_name -> ingredients_balancer.Ingredient
+	46, // 75: ingredients_balancer.ShoppingListResponse.items:type_name -> ingredients_balancer.ShoppingItem
+	15, // 76: ingredients_balancer.ValidateRecipeRequest.recipe:type_name -> ingredients_balancer.Recipe
+	24, // 77: ingredients_balancer.PanScenario.pans:type_name -> ingredients_balancer.Pans
+	32, // 78: ingredients_balancer.ScenarioComparison.recipe_aggregate:type_name -> ingredients_balancer.RecipeAggregate
+	15, // 79: ingredients_balancer.CompareScenariosRequest.recipe:type_name -> ingredients_balancer.Recipe
+	51, // 80: ingredients_balancer.CompareScenariosRequest.scenarios:type_name -> ingredients_balancer.PanScenario
+	52, // 81: ingredients_balancer.CompareScenariosRequest.prices:type_name -> ingredients_balancer.IngredientPrice
+	53, // 82: ingredients_balancer.CompareScenariosResponse.scenarios:type_name -> ingredients_balancer.ScenarioComparison
+	33, // 83: ingredients_balancer.IngredientsBalancer.Balance:input_type -> ingredients_balancer.BalanceRequest
+	39, // 84: ingredients_balancer.IngredientsBalancer.ReverseBalance:input_type -> ingredients_balancer.ReverseBalanceRequest
+	43, // 85: ingredients_balancer.IngredientsBalancer.OptimizePans:input_type -> ingredients_balancer.OptimizePansRequest
+	47, // 86: ingredients_balancer.IngredientsBalancer.GenerateShoppingList:input_type -> ingredients_balancer.ShoppingListRequest
+	49, // 87: ingredients_balancer.IngredientsBalancer.ValidateRecipe:input_type -> ingredients_balancer.ValidateRecipeRequest
+	54, // 88: ingredients_balancer.IngredientsBalancer.CompareScenarios:input_type -> ingredients_balancer.CompareScenariosRequest
+	36, // 89: ingredients_balancer.IngredientsBalancer.Balance:output_type -> ingredients_balancer.BalanceResponse
+	40, // 90: ingredients_balancer.IngredientsBalancer.ReverseBalance:output_type -> ingredients_balancer.ReverseBalanceResponse
+	44, // 91: ingredients_balancer.IngredientsBalancer.OptimizePans:output_type -> ingredients_balancer.OptimizePansResponse
+	48, // 92: ingredients_balancer.IngredientsBalancer.GenerateShoppingList:output_type -> ingredients_balancer.ShoppingListResponse
+	50, // 93: ingredients_balancer.IngredientsBalancer.ValidateRecipe:output_type -> ingredients_balancer.ValidateRecipeResponse
+	55, // 94: ingredients_balancer.IngredientsBalancer.CompareScenarios:output_type -> ingredients_balancer.CompareScenariosResponse
+	89, // [89:95] is the sub-list for method output_type
+	83, // [83:89] is the sub-list for method input_type
+	83, // [83:83] is the sub-list for extension type_name
+	83, // [83:83] is the sub-list for extension extendee
+	0,  // [0:83] is the sub-list for field type_name
+}
+
+func init() { file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() }
+func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
+	if File_pkg_infrastructure_grpc_proto_ingredients_balancer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ingredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preferment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Dough); i {
 			case 0:
 				return &v.state
@@ -1045,8 +5250,236 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Topping); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessLoss); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoughTemperatureReadings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaterTemperature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fermentation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leavening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoughTargets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolvedTargets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanToppingLayers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToppingGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Steps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Measures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanPreparation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlicingPattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanSlices); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1057,8 +5490,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Step); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1069,8 +5502,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Steps); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pans); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1081,8 +5514,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recipe); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitIngredients); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1093,8 +5526,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Measures); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MixerProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1105,8 +5538,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pan); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanPortion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1117,8 +5550,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pans); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MixingBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1129,8 +5562,8 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SplitIngredients); i {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoughBallGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1141,7 +5574,31 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoughBallPortion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefermentSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecipeAggregate); i {
 			case 0:
 				return &v.state
@@ -1153,7 +5610,7 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BalanceRequest); i {
 			case 0:
 				return &v.state
@@ -1165,7 +5622,31 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleApplication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YeastSubstitution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BalanceResponse); i {
 			case 0:
 				return &v.state
@@ -1177,15 +5658,245 @@ func file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanSelection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServingsTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanAssortment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizePansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizePansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateRecipeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateRecipeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanScenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngredientPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScenarioComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareScenariosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareScenariosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_msgTypes[53].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_infrastructure_grpc_proto_ingredients_balancer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type IngredientsBalancerClient interface {
 	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	ReverseBalance(ctx context.Context, in *ReverseBalanceRequest, opts ...grpc.CallOption) (*ReverseBalanceResponse, error)
+	OptimizePans(ctx context.Context, in *OptimizePansRequest, opts ...grpc.CallOption) (*OptimizePansResponse, error)
+	GenerateShoppingList(ctx context.Context, in *ShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	ValidateRecipe(ctx context.Context, in *ValidateRecipeRequest, opts ...grpc.CallOption) (*ValidateRecipeResponse, error)
+	CompareScenarios(ctx context.Context, in *CompareScenariosRequest, opts ...grpc.CallOption) (*CompareScenariosResponse, error)
 }
 
 type ingredientsBalancerClient struct {
@@ -42,11 +47,61 @@ func (c *ingredientsBalancerClient) Balance(ctx context.Context, in *BalanceRequ
 	return out, nil
 }
 
+func (c *ingredientsBalancerClient) ReverseBalance(ctx context.Context, in *ReverseBalanceRequest, opts ...grpc.CallOption) (*ReverseBalanceResponse, error) {
+	out := new(ReverseBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ingredients_balancer.IngredientsBalancer/ReverseBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientsBalancerClient) OptimizePans(ctx context.Context, in *OptimizePansRequest, opts ...grpc.CallOption) (*OptimizePansResponse, error) {
+	out := new(OptimizePansResponse)
+	err := c.cc.Invoke(ctx, "/ingredients_balancer.IngredientsBalancer/OptimizePans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientsBalancerClient) GenerateShoppingList(ctx context.Context, in *ShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, "/ingredients_balancer.IngredientsBalancer/GenerateShoppingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientsBalancerClient) ValidateRecipe(ctx context.Context, in *ValidateRecipeRequest, opts ...grpc.CallOption) (*ValidateRecipeResponse, error) {
+	out := new(ValidateRecipeResponse)
+	err := c.cc.Invoke(ctx, "/ingredients_balancer.IngredientsBalancer/ValidateRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingredientsBalancerClient) CompareScenarios(ctx context.Context, in *CompareScenariosRequest, opts ...grpc.CallOption) (*CompareScenariosResponse, error) {
+	out := new(CompareScenariosResponse)
+	err := c.cc.Invoke(ctx, "/ingredients_balancer.IngredientsBalancer/CompareScenarios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IngredientsBalancerServer is the server API for IngredientsBalancer service.
 // All implementations must embed UnimplementedIngredientsBalancerServer
 // for forward compatibility
 type IngredientsBalancerServer interface {
 	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	ReverseBalance(context.Context, *ReverseBalanceRequest) (*ReverseBalanceResponse, error)
+	OptimizePans(context.Context, *OptimizePansRequest) (*OptimizePansResponse, error)
+	GenerateShoppingList(context.Context, *ShoppingListRequest) (*ShoppingListResponse, error)
+	ValidateRecipe(context.Context, *ValidateRecipeRequest) (*ValidateRecipeResponse, error)
+	CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error)
 	mustEmbedUnimplementedIngredientsBalancerServer()
 }
 
@@ -57,6 +112,21 @@ type UnimplementedIngredientsBalancerServer struct {
 func (UnimplementedIngredientsBalancerServer) Balance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
+func (UnimplementedIngredientsBalancerServer) ReverseBalance(context.Context, *ReverseBalanceRequest) (*ReverseBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseBalance not implemented")
+}
+func (UnimplementedIngredientsBalancerServer) OptimizePans(context.Context, *OptimizePansRequest) (*OptimizePansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizePans not implemented")
+}
+func (UnimplementedIngredientsBalancerServer) GenerateShoppingList(context.Context, *ShoppingListRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateShoppingList not implemented")
+}
+func (UnimplementedIngredientsBalancerServer) ValidateRecipe(context.Context, *ValidateRecipeRequest) (*ValidateRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRecipe not implemented")
+}
+func (UnimplementedIngredientsBalancerServer) CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareScenarios not implemented")
+}
 func (UnimplementedIngredientsBalancerServer) mustEmbedUnimplementedIngredientsBalancerServer() {}
 
 // UnsafeIngredientsBalancerServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +158,96 @@ func _IngredientsBalancer_Balance_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngredientsBalancer_ReverseBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientsBalancerServer).ReverseBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingredients_balancer.IngredientsBalancer/ReverseBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientsBalancerServer).ReverseBalance(ctx, req.(*ReverseBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientsBalancer_OptimizePans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizePansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientsBalancerServer).OptimizePans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingredients_balancer.IngredientsBalancer/OptimizePans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientsBalancerServer).OptimizePans(ctx, req.(*OptimizePansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientsBalancer_GenerateShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientsBalancerServer).GenerateShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingredients_balancer.IngredientsBalancer/GenerateShoppingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientsBalancerServer).GenerateShoppingList(ctx, req.(*ShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientsBalancer_ValidateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientsBalancerServer).ValidateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingredients_balancer.IngredientsBalancer/ValidateRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientsBalancerServer).ValidateRecipe(ctx, req.(*ValidateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngredientsBalancer_CompareScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngredientsBalancerServer).CompareScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingredients_balancer.IngredientsBalancer/CompareScenarios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngredientsBalancerServer).CompareScenarios(ctx, req.(*CompareScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IngredientsBalancer_ServiceDesc is the grpc.ServiceDesc for IngredientsBalancer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +259,26 @@ var IngredientsBalancer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balance",
 			Handler:    _IngredientsBalancer_Balance_Handler,
 		},
+		{
+			MethodName: "ReverseBalance",
+			Handler:    _IngredientsBalancer_ReverseBalance_Handler,
+		},
+		{
+			MethodName: "OptimizePans",
+			Handler:    _IngredientsBalancer_OptimizePans_Handler,
+		},
+		{
+			MethodName: "GenerateShoppingList",
+			Handler:    _IngredientsBalancer_GenerateShoppingList_Handler,
+		},
+		{
+			MethodName: "ValidateRecipe",
+			Handler:    _IngredientsBalancer_ValidateRecipe_Handler,
+		},
+		{
+			MethodName: "CompareScenarios",
+			Handler:    _IngredientsBalancer_CompareScenarios_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/infrastructure/grpc/proto/ingredients_balancer.proto",
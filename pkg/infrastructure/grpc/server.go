@@ -2,15 +2,28 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"math"
 
 	"github.com/google/uuid"
 
 	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+	"github.com/cfioretti/ingredients-balancer/pkg/infrastructure/export"
 	pb "github.com/cfioretti/ingredients-balancer/pkg/infrastructure/grpc/proto/generated"
 )
 
 type BalancerService interface {
 	Balance(context.Context, domain.Recipe, domain.Pans) (*domain.RecipeAggregate, error)
+	BalanceDoughBalls(context.Context, domain.Recipe, []domain.DoughBallGroup) (*domain.RecipeAggregate, error)
+	SubstituteYeast(context.Context, domain.Recipe, domain.YeastSubstitution) (domain.Recipe, []string, error)
+	ApplySubstitutionRules(context.Context, domain.Recipe, string, []string) (domain.Recipe, []domain.RuleApplication, error)
+	PlanMixingBatches(context.Context, domain.RecipeAggregate, domain.MixerProfile) (*domain.MixingPlan, error)
+	CalculateWaterTemperature(context.Context, domain.RecipeAggregate, domain.DoughTemperatureReadings) (*domain.WaterTemperature, error)
+	ReverseBalance(context.Context, domain.Recipe, []domain.PanCandidate, domain.Ingredient) (*domain.ReverseBalanceResult, error)
+	OptimizePans(context.Context, domain.Recipe, []domain.PanCandidate, domain.ServingsTarget) (*domain.PanOptimizationResult, error)
+	GenerateShoppingList(context.Context, []domain.RecipeAggregate, []domain.PackageSize, []domain.Ingredient) (*domain.ShoppingList, error)
+	ValidateRecipe(context.Context, domain.Recipe) domain.RecipeValidation
+	CompareScenarios(context.Context, domain.Recipe, []domain.PanScenario, int, []domain.IngredientPrice) ([]domain.ScenarioComparison, error)
 }
 
 type Server struct {
@@ -26,12 +39,60 @@ func NewServer(ingredientsBalancerService BalancerService) *Server {
 
 func (s *Server) Balance(ctx context.Context, req *pb.BalanceRequest) (*pb.BalanceResponse, error) {
 	recipe := toDomainRecipe(req.GetRecipe())
-	pans := toDomainPans(req.GetPans())
 
-	result, err := s.ingredientsBalancerService.Balance(ctx, recipe, pans)
+	var (
+		result       *domain.RecipeAggregate
+		appliedRules []domain.RuleApplication
+		notes        []string
+		err          error
+	)
+	if req.GetTenant() != "" || len(req.GetRuleSets()) > 0 {
+		recipe, appliedRules, err = s.ingredientsBalancerService.ApplySubstitutionRules(ctx, recipe, req.GetTenant(), req.GetRuleSets())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.GetYeastSubstitution() != nil {
+		recipe, notes, err = s.ingredientsBalancerService.SubstituteYeast(ctx, recipe, toDomainYeastSubstitution(req.GetYeastSubstitution()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.GetDoughBalls()) > 0 {
+		if len(req.GetPans().GetPans()) > 0 {
+			return nil, errors.New("pans and dough balls cannot be combined")
+		}
+		result, err = s.ingredientsBalancerService.BalanceDoughBalls(ctx, recipe, toDomainDoughBallGroups(req.GetDoughBalls()))
+	} else {
+		result, err = s.ingredientsBalancerService.Balance(ctx, recipe, toDomainPans(req.GetPans()))
+	}
 	if err != nil {
 		return nil, err
 	}
+	result.Notes = append(result.Notes, notes...)
+	result.AppliedRules = appliedRules
+
+	if req.GetMixer() != nil {
+		mixingPlan, err := s.ingredientsBalancerService.PlanMixingBatches(ctx, *result, toDomainMixerProfile(req.GetMixer()))
+		if err != nil {
+			return nil, err
+		}
+		result.MixingBatches = mixingPlan.Batches
+		if mixingPlan.DoughToMix != nil {
+			result.DoughToMix = mixingPlan.DoughToMix
+			result.ProcessLossWeight = mixingPlan.ProcessLossWeight
+		}
+	}
+
+	if req.GetTemperatures() != nil {
+		waterTemperature, err := s.ingredientsBalancerService.CalculateWaterTemperature(ctx, *result, toDomainDoughTemperatureReadings(req.GetTemperatures()))
+		if err != nil {
+			return nil, err
+		}
+		result.Dough.WaterTemperature = waterTemperature
+	}
 
 	responseProto := toProtoRecipeAggregate(result)
 
@@ -40,51 +101,313 @@ func (s *Server) Balance(ctx context.Context, req *pb.BalanceRequest) (*pb.Balan
 	}, nil
 }
 
+func (s *Server) ReverseBalance(ctx context.Context, req *pb.ReverseBalanceRequest) (*pb.ReverseBalanceResponse, error) {
+	candidates := toDomainPanCandidates(req.GetCandidates())
+	limitingIngredient := domain.Ingredient{
+		Name:   req.GetLimitingIngredient().GetName(),
+		Amount: req.GetLimitingIngredient().GetAmount(),
+	}
+
+	result, err := s.ingredientsBalancerService.ReverseBalance(ctx, toDomainRecipe(req.GetRecipe()), candidates, limitingIngredient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReverseBalanceResponse{
+		Selections:      toProtoPanSelections(result.Selections),
+		RecipeAggregate: toProtoRecipeAggregate(&result.RecipeAggregate),
+		UsedAmount:      result.UsedAmount,
+		LeftoverAmount:  result.LeftoverAmount,
+	}, nil
+}
+
+func (s *Server) OptimizePans(ctx context.Context, req *pb.OptimizePansRequest) (*pb.OptimizePansResponse, error) {
+	slicesPerShape := make(map[string]int, len(req.GetTarget().GetSlicesPerShape()))
+	for shape, slices := range req.GetTarget().GetSlicesPerShape() {
+		slicesPerShape[shape] = int(slices)
+	}
+	target := domain.ServingsTarget{
+		Servings:       int(req.GetTarget().GetServings()),
+		SlicesPerShape: slicesPerShape,
+	}
+
+	result, err := s.ingredientsBalancerService.OptimizePans(ctx, toDomainRecipe(req.GetRecipe()), toDomainPanCandidates(req.GetCatalog()), target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OptimizePansResponse{
+		Balance: &pb.BalanceResponse{
+			RecipeAggregate: toProtoRecipeAggregate(&result.RecipeAggregate),
+		},
+		Assortment: &pb.PanAssortment{
+			Selections:    toProtoPanSelections(result.Assortment.Selections),
+			Servings:      int32(result.Assortment.Servings),
+			LeftoverDough: result.Assortment.LeftoverDough,
+			Utilization:   result.Assortment.Utilization,
+		},
+	}, nil
+}
+
+func (s *Server) GenerateShoppingList(ctx context.Context, req *pb.ShoppingListRequest) (*pb.ShoppingListResponse, error) {
+	recipeAggregates := make([]domain.RecipeAggregate, 0, len(req.GetRecipeAggregates()))
+	for _, protoRecipeAggregate := range req.GetRecipeAggregates() {
+		recipeAggregates = append(recipeAggregates, toDomainRecipeAggregate(protoRecipeAggregate))
+	}
+
+	shoppingList, err := s.ingredientsBalancerService.GenerateShoppingList(
+		ctx,
+		recipeAggregates,
+		toDomainPackageSizes(req.GetPackageSizes()),
+		toDomainIngredients(req.GetStock()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonData, err := export.ShoppingListToJSON(*shoppingList)
+	if err != nil {
+		return nil, err
+	}
+	csvData, err := export.ShoppingListToCSV(*shoppingList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ShoppingListResponse{
+		Items: toProtoShoppingItems(shoppingList.Items),
+		Json:  string(jsonData),
+		Csv:   string(csvData),
+	}, nil
+}
+
+func (s *Server) ValidateRecipe(ctx context.Context, req *pb.ValidateRecipeRequest) (*pb.ValidateRecipeResponse, error) {
+	validation := s.ingredientsBalancerService.ValidateRecipe(ctx, toDomainRecipe(req.GetRecipe()))
+
+	return &pb.ValidateRecipeResponse{
+		Valid:  validation.Valid,
+		Issues: validation.Issues,
+	}, nil
+}
+
+func (s *Server) CompareScenarios(ctx context.Context, req *pb.CompareScenariosRequest) (*pb.CompareScenariosResponse, error) {
+	scenarios := make([]domain.PanScenario, 0, len(req.GetScenarios()))
+	for _, protoScenario := range req.GetScenarios() {
+		scenarios = append(scenarios, domain.PanScenario{
+			Name: protoScenario.GetName(),
+			Pans: toDomainPans(protoScenario.GetPans()),
+		})
+	}
+
+	prices := make([]domain.IngredientPrice, 0, len(req.GetPrices()))
+	for _, protoPrice := range req.GetPrices() {
+		prices = append(prices, domain.IngredientPrice{
+			Name:     protoPrice.GetName(),
+			Price:    protoPrice.GetPrice(),
+			Quantity: protoPrice.GetQuantity(),
+		})
+	}
+
+	comparisons, err := s.ingredientsBalancerService.CompareScenarios(ctx, toDomainRecipe(req.GetRecipe()), scenarios, int(req.GetServings()), prices)
+	if err != nil {
+		return nil, err
+	}
+
+	protoComparisons := make([]*pb.ScenarioComparison, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		protoComparisons = append(protoComparisons, &pb.ScenarioComparison{
+			Name:                comparison.Name,
+			RecipeAggregate:     toProtoRecipeAggregate(&comparison.RecipeAggregate),
+			TotalDough:          comparison.TotalDough,
+			TotalTopping:        comparison.TotalTopping,
+			Servings:            int32(comparison.Servings),
+			Leftover:            comparison.Leftover,
+			Utilization:         comparison.Utilization,
+			Cost:                comparison.Cost,
+			UnpricedIngredients: comparison.UnpricedIngredients,
+		})
+	}
+
+	return &pb.CompareScenariosResponse{Scenarios: protoComparisons}, nil
+}
+
 func toDomainRecipe(protoRecipe *pb.Recipe) domain.Recipe {
-	recipeUUID, _ := uuid.Parse(protoRecipe.Uuid)
+	recipeUUID, _ := uuid.Parse(protoRecipe.GetUuid())
 
 	return domain.Recipe{
-		Id:          int(protoRecipe.Id),
-		Uuid:        recipeUUID,
-		Name:        protoRecipe.Name,
-		Description: protoRecipe.Description,
-		Author:      protoRecipe.Author,
-		Dough:       toDomainDough(protoRecipe.Dough),
-		Topping:     toDomainTopping(protoRecipe.Topping),
-		Steps:       toDomainSteps(protoRecipe.Steps),
+		Id:             int(protoRecipe.GetId()),
+		Uuid:           recipeUUID,
+		Name:           protoRecipe.GetName(),
+		Description:    protoRecipe.GetDescription(),
+		Author:         protoRecipe.GetAuthor(),
+		Dough:          toDomainDough(protoRecipe.GetDough()),
+		Topping:        toDomainTopping(protoRecipe.GetTopping()),
+		ToppingLayers:  toDomainToppings(protoRecipe.GetToppingLayers()),
+		ToppingRecipes: toDomainToppings(protoRecipe.GetToppingRecipes()),
+		Steps:          toDomainSteps(protoRecipe.GetSteps()),
+	}
+}
+
+func toDomainRecipeAggregate(protoRecipeAggregate *pb.RecipeAggregate) domain.RecipeAggregate {
+	return domain.RecipeAggregate{
+		Recipe:           toDomainRecipe(protoRecipeAggregate.GetRecipe()),
+		SplitIngredients: toDomainSplitIngredients(protoRecipeAggregate.GetSplitIngredients()),
+		PanPreparation:   toDomainIngredients(protoRecipeAggregate.GetPanPreparation()),
+		DoughToMix:       toDomainDoughToMix(protoRecipeAggregate.GetDoughToMix()),
+	}
+}
+
+func toDomainDoughToMix(protoDough *pb.Dough) *domain.Dough {
+	if protoDough == nil {
+		return nil
+	}
+	dough := toDomainDough(protoDough)
+	return &dough
+}
+
+func toDomainSplitIngredients(protoSplitIngredients *pb.SplitIngredients) domain.SplitIngredients {
+	splitDoughs := make([]domain.Dough, 0, len(protoSplitIngredients.GetSplitDough()))
+	for _, protoDough := range protoSplitIngredients.GetSplitDough() {
+		splitDoughs = append(splitDoughs, toDomainDough(protoDough))
+	}
+
+	splitToppings := make([]domain.Topping, 0, len(protoSplitIngredients.GetSplitTopping()))
+	for _, protoTopping := range protoSplitIngredients.GetSplitTopping() {
+		splitToppings = append(splitToppings, toDomainTopping(protoTopping))
+	}
+
+	var splitToppingLayers []domain.PanToppingLayers
+	for _, protoPanLayers := range protoSplitIngredients.GetSplitToppingLayers() {
+		splitToppingLayers = append(splitToppingLayers, domain.PanToppingLayers{
+			Name:   protoPanLayers.GetName(),
+			Layers: toDomainToppings(protoPanLayers.GetLayers()),
+		})
+	}
+
+	var splitTopCrusts []domain.Dough
+	for _, protoDough := range protoSplitIngredients.GetSplitTopCrust() {
+		splitTopCrusts = append(splitTopCrusts, toDomainDough(protoDough))
+	}
+
+	var splitPanPreparation []domain.PanPreparation
+	for _, protoPreparation := range protoSplitIngredients.GetSplitPanPreparation() {
+		splitPanPreparation = append(splitPanPreparation, domain.PanPreparation{
+			Name:        protoPreparation.GetName(),
+			Profile:     protoPreparation.GetProfile(),
+			Ingredients: toDomainIngredients(protoPreparation.GetIngredients()),
+		})
+	}
+
+	var splitSlices []domain.PanSlices
+	for _, protoSlices := range protoSplitIngredients.GetSplitSlices() {
+		splitSlices = append(splitSlices, domain.PanSlices{
+			Name:    protoSlices.GetName(),
+			Count:   int(protoSlices.GetCount()),
+			Weight:  protoSlices.GetWeight(),
+			Dough:   toDomainIngredients(protoSlices.GetDough()),
+			Topping: toDomainIngredients(protoSlices.GetTopping()),
+		})
+	}
+
+	return domain.SplitIngredients{
+		SplitDough:          splitDoughs,
+		SplitTopping:        splitToppings,
+		SplitToppingLayers:  splitToppingLayers,
+		SplitTopCrust:       splitTopCrusts,
+		SplitPanPreparation: splitPanPreparation,
+		SplitSlices:         splitSlices,
 	}
 }
 
 func toDomainDough(protoDough *pb.Dough) domain.Dough {
 	return domain.Dough{
-		Name:             protoDough.Name,
-		PercentVariation: protoDough.PercentVariation,
-		Ingredients:      toDomainIngredients(protoDough.Ingredients),
+		Name:             protoDough.GetName(),
+		PercentVariation: protoDough.GetPercentVariation(),
+		Ingredients:      toDomainIngredients(protoDough.GetIngredients()),
+		Preferment:       toDomainPreferment(protoDough.GetPreferment()),
+		Targets:          toDomainDoughTargets(protoDough.GetTargets()),
+		Fermentation:     toDomainFermentation(protoDough.GetFermentation()),
+		ProcessLoss:      toDomainProcessLoss(protoDough.GetProcessLoss()),
+	}
+}
+
+func toDomainProcessLoss(protoLoss *pb.ProcessLoss) *domain.ProcessLoss {
+	if protoLoss == nil {
+		return nil
+	}
+	return &domain.ProcessLoss{
+		MixerPercentage: protoLoss.GetMixerPercentage(),
+		MinimumLoss:     protoLoss.GetMinimumLoss(),
+		PerBatchLoss:    protoLoss.GetPerBatchLoss(),
+	}
+}
+
+func toDomainDoughTargets(protoTargets *pb.DoughTargets) domain.DoughTargets {
+	return domain.DoughTargets{
+		Hydration: protoTargets.GetHydration(),
+		Salt:      protoTargets.GetSalt(),
+		Yeast:     protoTargets.GetYeast(),
+	}
+}
+
+func toDomainFermentation(protoFermentation *pb.Fermentation) *domain.Fermentation {
+	if protoFermentation == nil {
+		return nil
+	}
+	return &domain.Fermentation{
+		Hours:       protoFermentation.Hours,
+		Temperature: protoFermentation.Temperature,
+		YeastType:   protoFermentation.YeastType,
+	}
+}
+
+func toDomainPreferment(protoPreferment *pb.Preferment) *domain.Preferment {
+	if protoPreferment == nil {
+		return nil
+	}
+	return &domain.Preferment{
+		Type:            protoPreferment.Type,
+		Hydration:       protoPreferment.Hydration,
+		FlourPercentage: protoPreferment.FlourPercentage,
+		YeastPercentage: protoPreferment.YeastPercentage,
 	}
 }
 
 func toDomainTopping(protoTopping *pb.Topping) domain.Topping {
 	return domain.Topping{
-		Name:          protoTopping.Name,
-		ReferenceArea: protoTopping.ReferenceArea,
-		Ingredients:   toDomainIngredients(protoTopping.Ingredients),
+		Name:          protoTopping.GetName(),
+		ReferenceArea: protoTopping.GetReferenceArea(),
+		Ingredients:   toDomainIngredients(protoTopping.GetIngredients()),
+		Stage:         protoTopping.GetStage(),
+		Scaling:       protoTopping.GetScaling(),
 	}
 }
 
+func toDomainToppings(protoToppings []*pb.Topping) []domain.Topping {
+	var toppings []domain.Topping
+	for _, protoTopping := range protoToppings {
+		toppings = append(toppings, toDomainTopping(protoTopping))
+	}
+	return toppings
+}
+
 func toDomainIngredients(protoIngredients []*pb.Ingredient) []domain.Ingredient {
 	ingredients := make([]domain.Ingredient, 0, len(protoIngredients))
 	for _, protoIngredient := range protoIngredients {
 		ingredients = append(ingredients, domain.Ingredient{
-			Name:   protoIngredient.Name,
-			Amount: protoIngredient.Amount,
+			Name:      protoIngredient.Name,
+			Amount:    protoIngredient.Amount,
+			Type:      protoIngredient.Type,
+			Hydration: protoIngredient.Hydration,
+			Scaling:   protoIngredient.Scaling,
 		})
 	}
 	return ingredients
 }
 
 func toDomainSteps(protoSteps *pb.Steps) domain.Steps {
-	steps := make([]domain.Step, 0, len(protoSteps.Steps))
-	for _, protoStep := range protoSteps.Steps {
+	steps := make([]domain.Step, 0, len(protoSteps.GetSteps()))
+	for _, protoStep := range protoSteps.GetSteps() {
 		steps = append(steps, domain.Step{
 			Id:          int(protoStep.Id),
 			StepNumber:  int(protoStep.StepNumber),
@@ -92,49 +415,201 @@ func toDomainSteps(protoSteps *pb.Steps) domain.Steps {
 		})
 	}
 	return domain.Steps{
-		RecipeId: int(protoSteps.RecipeId),
+		RecipeId: int(protoSteps.GetRecipeId()),
 		Steps:    steps,
 	}
 }
 
-func toDomainPans(protoPans *pb.Pans) domain.Pans {
-	pans := make([]domain.Pan, 0, len(protoPans.Pans))
-	for _, protoPan := range protoPans.Pans {
-		pans = append(pans, domain.Pan{
-			Shape: protoPan.Shape,
-			Measures: domain.Measures{
-				Diameter: toPointer(protoPan.Measures.Diameter),
-				Edge:     toPointer(protoPan.Measures.Edge),
-				Width:    toPointer(protoPan.Measures.Width),
-				Length:   toPointer(protoPan.Measures.Length),
-			},
-			Name: protoPan.Name,
-			Area: protoPan.Area,
+func toDomainDoughBallGroups(protoGroups []*pb.DoughBallGroup) []domain.DoughBallGroup {
+	groups := make([]domain.DoughBallGroup, 0, len(protoGroups))
+	for _, protoGroup := range protoGroups {
+		groups = append(groups, domain.DoughBallGroup{
+			Name:            protoGroup.Name,
+			Count:           int(protoGroup.Count),
+			BallWeight:      protoGroup.BallWeight,
+			Diameter:        protoGroup.Diameter,
+			ThicknessFactor: protoGroup.ThicknessFactor,
+			ToppingRecipe:   protoGroup.ToppingRecipe,
 		})
 	}
+	return groups
+}
+
+func toDomainMixerProfile(protoMixer *pb.MixerProfile) domain.MixerProfile {
+	return domain.MixerProfile{
+		MaxDoughWeight: protoMixer.MaxDoughWeight,
+		MinDoughWeight: protoMixer.MinDoughWeight,
+		BowlCount:      int(protoMixer.BowlCount),
+	}
+}
+
+func toDomainYeastSubstitution(protoSubstitution *pb.YeastSubstitution) domain.YeastSubstitution {
+	return domain.YeastSubstitution{
+		Target:           protoSubstitution.GetTarget(),
+		Factors:          protoSubstitution.GetFactors(),
+		StarterHydration: protoSubstitution.GetStarterHydration(),
+	}
+}
+
+func toDomainDoughTemperatureReadings(protoReadings *pb.DoughTemperatureReadings) domain.DoughTemperatureReadings {
+	return domain.DoughTemperatureReadings{
+		DesiredDoughTemperature: protoReadings.GetDesiredDoughTemperature(),
+		FlourTemperature:        protoReadings.GetFlourTemperature(),
+		RoomTemperature:         protoReadings.GetRoomTemperature(),
+		PrefermentTemperature:   protoReadings.GetPrefermentTemperature(),
+		FrictionFactor:          protoReadings.GetFrictionFactor(),
+		TapWaterTemperature:     protoReadings.GetTapWaterTemperature(),
+	}
+}
+
+func toDomainPackageSizes(protoPackageSizes []*pb.PackageSize) []domain.PackageSize {
+	packageSizes := make([]domain.PackageSize, 0, len(protoPackageSizes))
+	for _, protoPackageSize := range protoPackageSizes {
+		packageSizes = append(packageSizes, domain.PackageSize{
+			Name: protoPackageSize.Name,
+			Size: protoPackageSize.Size,
+		})
+	}
+	return packageSizes
+}
+
+func toDomainPans(protoPans *pb.Pans) domain.Pans {
+	pans := make([]domain.Pan, 0, len(protoPans.GetPans()))
+	for _, protoPan := range protoPans.GetPans() {
+		pans = append(pans, toDomainPan(protoPan))
+	}
 	return domain.Pans{
-		Pans:      pans,
-		TotalArea: protoPans.TotalArea,
+		Pans:             pans,
+		TotalArea:        protoPans.GetTotalArea(),
+		DefaultRimWidths: protoPans.GetDefaultRimWidths(),
+		Profiles:         toDomainPanProfiles(protoPans.GetProfiles()),
+	}
+}
+
+func toDomainPan(protoPan *pb.Pan) domain.Pan {
+	return domain.Pan{
+		Shape:               protoPan.GetShape(),
+		Measures:            toDomainMeasures(protoPan.GetMeasures()),
+		Name:                protoPan.GetName(),
+		Area:                protoPan.GetArea(),
+		Slices:              int(protoPan.GetSlices()),
+		ToppingRecipe:       protoPan.GetToppingRecipe(),
+		Sections:            toDomainPanSections(protoPan.GetSections()),
+		RimWidth:            protoPan.GetRimWidth(),
+		Deep:                protoPan.GetDeep(),
+		TopCrustShare:       protoPan.GetTopCrustShare(),
+		Material:            protoPan.GetMaterial(),
+		Style:               protoPan.GetStyle(),
+		Profile:             protoPan.GetProfile(),
+		ThicknessMultiplier: protoPan.GetThicknessMultiplier(),
+		Slicing:             toDomainSlicingPattern(protoPan.GetSlicing()),
+	}
+}
+
+func toDomainPanProfiles(protoProfiles []*pb.PanProfile) []domain.PanProfile {
+	var profiles []domain.PanProfile
+	for _, protoProfile := range protoProfiles {
+		profiles = append(profiles, domain.PanProfile{
+			Name:          protoProfile.GetName(),
+			Material:      protoProfile.GetMaterial(),
+			Style:         protoProfile.GetStyle(),
+			ReferenceArea: protoProfile.GetReferenceArea(),
+			Ingredients:   toDomainIngredients(protoProfile.GetIngredients()),
+		})
+	}
+	return profiles
+}
+
+func toDomainSlicingPattern(protoSlicing *pb.SlicingPattern) *domain.SlicingPattern {
+	if protoSlicing == nil {
+		return nil
+	}
+	return &domain.SlicingPattern{
+		Pattern: protoSlicing.GetPattern(),
+		Rows:    int(protoSlicing.GetRows()),
+		Columns: int(protoSlicing.GetColumns()),
+		Slices:  int(protoSlicing.GetSlices()),
+	}
+}
+
+func toDomainPanSections(protoSections []*pb.PanSection) []domain.PanSection {
+	var sections []domain.PanSection
+	for _, protoSection := range protoSections {
+		sections = append(sections, domain.PanSection{
+			Name:          protoSection.GetName(),
+			Fraction:      protoSection.GetFraction(),
+			ToppingRecipe: protoSection.GetToppingRecipe(),
+		})
+	}
+	return sections
+}
+
+func toDomainPanCandidates(protoCandidates []*pb.PanCandidate) []domain.PanCandidate {
+	candidates := make([]domain.PanCandidate, 0, len(protoCandidates))
+	for _, protoCandidate := range protoCandidates {
+		candidates = append(candidates, domain.PanCandidate{
+			Pan:         toDomainPan(protoCandidate.GetPan()),
+			MaxQuantity: toPointer(protoCandidate.MaxQuantity),
+		})
+	}
+	return candidates
+}
+
+func toDomainMeasures(protoMeasures *pb.Measures) domain.Measures {
+	if protoMeasures == nil {
+		return domain.Measures{}
+	}
+	return domain.Measures{
+		Unit:         protoMeasures.GetUnit(),
+		Diameter:     toMeasure(protoMeasures.DiameterValue, protoMeasures.Diameter),
+		Edge:         toMeasure(protoMeasures.EdgeValue, protoMeasures.Edge),
+		Width:        toMeasure(protoMeasures.WidthValue, protoMeasures.Width),
+		Length:       toMeasure(protoMeasures.LengthValue, protoMeasures.Length),
+		Height:       protoMeasures.Height,
+		WallCoverage: protoMeasures.WallCoverage,
+		MajorAxis:    protoMeasures.MajorAxis,
+		MinorAxis:    protoMeasures.MinorAxis,
+		Sides:        toPointer(protoMeasures.Sides),
+		CornerRadius: protoMeasures.CornerRadius,
+		Vertices:     toDomainPoints(protoMeasures.GetVertices()),
 	}
 }
 
 func toProtoRecipeAggregate(domainRecipeAggregate *domain.RecipeAggregate) *pb.RecipeAggregate {
 	return &pb.RecipeAggregate{
-		Recipe:           toProtoRecipe(domainRecipeAggregate.Recipe),
-		SplitIngredients: toProtoSplitIngredients(domainRecipeAggregate.SplitIngredients),
+		Recipe:             toProtoRecipe(domainRecipeAggregate.Recipe),
+		SplitIngredients:   toProtoSplitIngredients(domainRecipeAggregate.SplitIngredients),
+		MixingBatches:      toProtoMixingBatches(domainRecipeAggregate.MixingBatches),
+		DoughBalls:         toProtoDoughBallPortions(domainRecipeAggregate.DoughBalls),
+		SurplusDough:       toProtoDough(domainRecipeAggregate.SurplusDough),
+		PrefermentSplit:    toProtoPrefermentSplit(domainRecipeAggregate.PrefermentSplit),
+		FormulaPercentages: toProtoIngredients(domainRecipeAggregate.FormulaPercentages),
+		EffectiveHydration: domainRecipeAggregate.EffectiveHydration,
+		Warnings:           domainRecipeAggregate.Warnings,
+		SolvedTargets:      toProtoSolvedTargets(domainRecipeAggregate.SolvedTargets),
+		Leavening:          toProtoLeavening(domainRecipeAggregate.Leavening),
+		Notes:              domainRecipeAggregate.Notes,
+		AppliedRules:       toProtoRuleApplications(domainRecipeAggregate.AppliedRules),
+		ToppingGroups:      toProtoToppingGroups(domainRecipeAggregate.ToppingGroups),
+		ToppingArea:        domainRecipeAggregate.ToppingArea,
+		PanPreparation:     toProtoIngredients(domainRecipeAggregate.PanPreparation),
+		DoughToMix:         toProtoDoughToMix(domainRecipeAggregate.DoughToMix),
+		ProcessLossWeight:  domainRecipeAggregate.ProcessLossWeight,
 	}
 }
 
 func toProtoRecipe(domainRecipe domain.Recipe) *pb.Recipe {
 	return &pb.Recipe{
-		Id:          int32(domainRecipe.Id),
-		Uuid:        domainRecipe.Uuid.String(),
-		Name:        domainRecipe.Name,
-		Description: domainRecipe.Description,
-		Author:      domainRecipe.Author,
-		Dough:       toProtoDough(domainRecipe.Dough),
-		Topping:     toProtoTopping(domainRecipe.Topping),
-		Steps:       toProtoSteps(domainRecipe.Steps),
+		Id:             int32(domainRecipe.Id),
+		Uuid:           domainRecipe.Uuid.String(),
+		Name:           domainRecipe.Name,
+		Description:    domainRecipe.Description,
+		Author:         domainRecipe.Author,
+		Dough:          toProtoDough(domainRecipe.Dough),
+		Topping:        toProtoTopping(domainRecipe.Topping),
+		ToppingLayers:  toProtoToppings(domainRecipe.ToppingLayers),
+		ToppingRecipes: toProtoToppings(domainRecipe.ToppingRecipes),
+		Steps:          toProtoSteps(domainRecipe.Steps),
 	}
 }
 
@@ -143,6 +618,107 @@ func toProtoDough(domainDough domain.Dough) *pb.Dough {
 		Name:             domainDough.Name,
 		PercentVariation: domainDough.PercentVariation,
 		Ingredients:      toProtoIngredients(domainDough.Ingredients),
+		Preferment:       toProtoPreferment(domainDough.Preferment),
+		Targets:          toProtoDoughTargets(domainDough.Targets),
+		Fermentation:     toProtoFermentation(domainDough.Fermentation),
+		WaterTemperature: toProtoWaterTemperature(domainDough.WaterTemperature),
+		ProcessLoss:      toProtoProcessLoss(domainDough.ProcessLoss),
+	}
+}
+
+func toProtoProcessLoss(domainLoss *domain.ProcessLoss) *pb.ProcessLoss {
+	if domainLoss == nil {
+		return nil
+	}
+	return &pb.ProcessLoss{
+		MixerPercentage: domainLoss.MixerPercentage,
+		MinimumLoss:     domainLoss.MinimumLoss,
+		PerBatchLoss:    domainLoss.PerBatchLoss,
+	}
+}
+
+func toProtoDoughToMix(domainDough *domain.Dough) *pb.Dough {
+	if domainDough == nil {
+		return nil
+	}
+	return toProtoDough(*domainDough)
+}
+
+func toProtoDoughTargets(domainTargets domain.DoughTargets) *pb.DoughTargets {
+	return &pb.DoughTargets{
+		Hydration: domainTargets.Hydration,
+		Salt:      domainTargets.Salt,
+		Yeast:     domainTargets.Yeast,
+	}
+}
+
+func toProtoSolvedTargets(domainSolvedTargets *domain.SolvedTargets) *pb.SolvedTargets {
+	if domainSolvedTargets == nil {
+		return nil
+	}
+	return &pb.SolvedTargets{
+		TotalFlour: domainSolvedTargets.TotalFlour,
+		Water:      domainSolvedTargets.Water,
+		Salt:       domainSolvedTargets.Salt,
+		Yeast:      domainSolvedTargets.Yeast,
+	}
+}
+
+func toProtoFermentation(domainFermentation *domain.Fermentation) *pb.Fermentation {
+	if domainFermentation == nil {
+		return nil
+	}
+	return &pb.Fermentation{
+		Hours:       domainFermentation.Hours,
+		Temperature: domainFermentation.Temperature,
+		YeastType:   domainFermentation.YeastType,
+	}
+}
+
+func toProtoWaterTemperature(domainWaterTemperature *domain.WaterTemperature) *pb.WaterTemperature {
+	if domainWaterTemperature == nil {
+		return nil
+	}
+	return &pb.WaterTemperature{
+		Temperature: domainWaterTemperature.Temperature,
+		IceNeeded:   domainWaterTemperature.IceNeeded,
+		IceAmount:   domainWaterTemperature.IceAmount,
+		WaterAmount: domainWaterTemperature.WaterAmount,
+	}
+}
+
+func toProtoLeavening(domainLeavening *domain.Leavening) *pb.Leavening {
+	if domainLeavening == nil {
+		return nil
+	}
+	return &pb.Leavening{
+		YeastType:       domainLeavening.YeastType,
+		YeastPercentage: domainLeavening.YeastPercentage,
+		FreshYeast:      domainLeavening.FreshYeast,
+		InstantYeast:    domainLeavening.InstantYeast,
+		DryYeast:        domainLeavening.DryYeast,
+	}
+}
+
+func toProtoPreferment(domainPreferment *domain.Preferment) *pb.Preferment {
+	if domainPreferment == nil {
+		return nil
+	}
+	return &pb.Preferment{
+		Type:            domainPreferment.Type,
+		Hydration:       domainPreferment.Hydration,
+		FlourPercentage: domainPreferment.FlourPercentage,
+		YeastPercentage: domainPreferment.YeastPercentage,
+	}
+}
+
+func toProtoPrefermentSplit(domainPrefermentSplit *domain.PrefermentSplit) *pb.PrefermentSplit {
+	if domainPrefermentSplit == nil {
+		return nil
+	}
+	return &pb.PrefermentSplit{
+		Preferment: toProtoDough(domainPrefermentSplit.Preferment),
+		FinalDough: toProtoDough(domainPrefermentSplit.FinalDough),
 	}
 }
 
@@ -151,15 +727,28 @@ func toProtoTopping(domainTopping domain.Topping) *pb.Topping {
 		Name:          domainTopping.Name,
 		ReferenceArea: domainTopping.ReferenceArea,
 		Ingredients:   toProtoIngredients(domainTopping.Ingredients),
+		Stage:         domainTopping.Stage,
+		Scaling:       domainTopping.Scaling,
+	}
+}
+
+func toProtoToppings(domainToppings []domain.Topping) []*pb.Topping {
+	var protoToppings []*pb.Topping
+	for _, domainTopping := range domainToppings {
+		protoToppings = append(protoToppings, toProtoTopping(domainTopping))
 	}
+	return protoToppings
 }
 
 func toProtoIngredients(domainIngredients []domain.Ingredient) []*pb.Ingredient {
 	protoIngredients := make([]*pb.Ingredient, 0, len(domainIngredients))
 	for _, domainIngredient := range domainIngredients {
 		protoIngredients = append(protoIngredients, &pb.Ingredient{
-			Name:   domainIngredient.Name,
-			Amount: domainIngredient.Amount,
+			Name:      domainIngredient.Name,
+			Amount:    domainIngredient.Amount,
+			Type:      domainIngredient.Type,
+			Hydration: domainIngredient.Hydration,
+			Scaling:   domainIngredient.Scaling,
 		})
 	}
 	return protoIngredients
@@ -191,12 +780,217 @@ func toProtoSplitIngredients(domainSplitIngredients domain.SplitIngredients) *pb
 		protoSplitToppings = append(protoSplitToppings, toProtoTopping(domainTopping))
 	}
 
+	var protoSplitToppingLayers []*pb.PanToppingLayers
+	for _, domainPanLayers := range domainSplitIngredients.SplitToppingLayers {
+		protoSplitToppingLayers = append(protoSplitToppingLayers, &pb.PanToppingLayers{
+			Name:   domainPanLayers.Name,
+			Layers: toProtoToppings(domainPanLayers.Layers),
+		})
+	}
+
+	var protoSplitTopCrusts []*pb.Dough
+	for _, domainDough := range domainSplitIngredients.SplitTopCrust {
+		protoSplitTopCrusts = append(protoSplitTopCrusts, toProtoDough(domainDough))
+	}
+
+	var protoSplitPanPreparation []*pb.PanPreparation
+	for _, domainPreparation := range domainSplitIngredients.SplitPanPreparation {
+		protoSplitPanPreparation = append(protoSplitPanPreparation, &pb.PanPreparation{
+			Name:        domainPreparation.Name,
+			Profile:     domainPreparation.Profile,
+			Ingredients: toProtoIngredients(domainPreparation.Ingredients),
+		})
+	}
+
+	var protoSplitSlices []*pb.PanSlices
+	for _, domainSlices := range domainSplitIngredients.SplitSlices {
+		protoSplitSlices = append(protoSplitSlices, &pb.PanSlices{
+			Name:    domainSlices.Name,
+			Count:   int32(domainSlices.Count),
+			Weight:  domainSlices.Weight,
+			Dough:   toProtoIngredients(domainSlices.Dough),
+			Topping: toProtoIngredients(domainSlices.Topping),
+		})
+	}
+
 	return &pb.SplitIngredients{
-		SplitDough:   protoSplitDoughs,
-		SplitTopping: protoSplitToppings,
+		SplitDough:          protoSplitDoughs,
+		SplitTopping:        protoSplitToppings,
+		SplitToppingLayers:  protoSplitToppingLayers,
+		SplitTopCrust:       protoSplitTopCrusts,
+		SplitPanPreparation: protoSplitPanPreparation,
+		SplitSlices:         protoSplitSlices,
+	}
+}
+
+func toProtoPan(domainPan domain.Pan) *pb.Pan {
+	return &pb.Pan{
+		Shape: domainPan.Shape,
+		Measures: &pb.Measures{
+			Unit:          domainPan.Measures.Unit,
+			Diameter:      toProtoMeasure(domainPan.Measures.Diameter),
+			DiameterValue: domainPan.Measures.Diameter,
+			Edge:          toProtoMeasure(domainPan.Measures.Edge),
+			EdgeValue:     domainPan.Measures.Edge,
+			Width:         toProtoMeasure(domainPan.Measures.Width),
+			WidthValue:    domainPan.Measures.Width,
+			Length:        toProtoMeasure(domainPan.Measures.Length),
+			LengthValue:   domainPan.Measures.Length,
+			Height:        domainPan.Measures.Height,
+			WallCoverage:  domainPan.Measures.WallCoverage,
+			MajorAxis:     domainPan.Measures.MajorAxis,
+			MinorAxis:     domainPan.Measures.MinorAxis,
+			Sides:         toProtoPointer(domainPan.Measures.Sides),
+			CornerRadius:  domainPan.Measures.CornerRadius,
+			Vertices:      toProtoPoints(domainPan.Measures.Vertices),
+		},
+		Name:                domainPan.Name,
+		Area:                domainPan.Area,
+		Slices:              int32(domainPan.Slices),
+		ToppingRecipe:       domainPan.ToppingRecipe,
+		Sections:            toProtoPanSections(domainPan.Sections),
+		RimWidth:            domainPan.RimWidth,
+		Deep:                domainPan.Deep,
+		TopCrustShare:       domainPan.TopCrustShare,
+		Material:            domainPan.Material,
+		Style:               domainPan.Style,
+		Profile:             domainPan.Profile,
+		ThicknessMultiplier: domainPan.ThicknessMultiplier,
+		Slicing:             toProtoSlicingPattern(domainPan.Slicing),
+	}
+}
+
+func toDomainPoints(protoPoints []*pb.Point) []domain.Point {
+	var points []domain.Point
+	for _, protoPoint := range protoPoints {
+		points = append(points, domain.Point{X: protoPoint.GetX(), Y: protoPoint.GetY()})
+	}
+	return points
+}
+
+func toProtoPoints(domainPoints []domain.Point) []*pb.Point {
+	var protoPoints []*pb.Point
+	for _, domainPoint := range domainPoints {
+		protoPoints = append(protoPoints, &pb.Point{X: domainPoint.X, Y: domainPoint.Y})
+	}
+	return protoPoints
+}
+
+func toProtoSlicingPattern(domainSlicing *domain.SlicingPattern) *pb.SlicingPattern {
+	if domainSlicing == nil {
+		return nil
+	}
+	return &pb.SlicingPattern{
+		Pattern: domainSlicing.Pattern,
+		Rows:    int32(domainSlicing.Rows),
+		Columns: int32(domainSlicing.Columns),
+		Slices:  int32(domainSlicing.Slices),
 	}
 }
 
+func toProtoPanSections(domainSections []domain.PanSection) []*pb.PanSection {
+	var protoSections []*pb.PanSection
+	for _, domainSection := range domainSections {
+		protoSections = append(protoSections, &pb.PanSection{
+			Name:          domainSection.Name,
+			Fraction:      domainSection.Fraction,
+			ToppingRecipe: domainSection.ToppingRecipe,
+		})
+	}
+	return protoSections
+}
+
+func toProtoPanSelections(domainSelections []domain.PanSelection) []*pb.PanSelection {
+	protoSelections := make([]*pb.PanSelection, 0, len(domainSelections))
+	for _, domainSelection := range domainSelections {
+		protoSelections = append(protoSelections, &pb.PanSelection{
+			Pan:      toProtoPan(domainSelection.Pan),
+			Quantity: int32(domainSelection.Quantity),
+		})
+	}
+	return protoSelections
+}
+
+func toProtoMixingBatches(domainMixingBatches []domain.MixingBatch) []*pb.MixingBatch {
+	protoMixingBatches := make([]*pb.MixingBatch, 0, len(domainMixingBatches))
+	for _, domainMixingBatch := range domainMixingBatches {
+		protoPans := make([]*pb.PanPortion, 0, len(domainMixingBatch.Pans))
+		for _, domainPanPortion := range domainMixingBatch.Pans {
+			protoPans = append(protoPans, &pb.PanPortion{
+				Name:        domainPanPortion.Name,
+				DoughWeight: domainPanPortion.DoughWeight,
+			})
+		}
+
+		protoMixingBatches = append(protoMixingBatches, &pb.MixingBatch{
+			Number:      int32(domainMixingBatch.Number),
+			Bowl:        int32(domainMixingBatch.Bowl),
+			DoughWeight: domainMixingBatch.DoughWeight,
+			Ingredients: toProtoIngredients(domainMixingBatch.Ingredients),
+			Pans:        protoPans,
+		})
+	}
+	return protoMixingBatches
+}
+
+func toProtoDoughBallPortions(domainPortions []domain.DoughBallPortion) []*pb.DoughBallPortion {
+	protoPortions := make([]*pb.DoughBallPortion, 0, len(domainPortions))
+	for _, domainPortion := range domainPortions {
+		protoPortions = append(protoPortions, &pb.DoughBallPortion{
+			Name:        domainPortion.Name,
+			Count:       int32(domainPortion.Count),
+			BallWeight:  domainPortion.BallWeight,
+			Ingredients: toProtoIngredients(domainPortion.Ingredients),
+		})
+	}
+	return protoPortions
+}
+
+func toProtoRuleApplications(domainApplications []domain.RuleApplication) []*pb.RuleApplication {
+	var protoApplications []*pb.RuleApplication
+	for _, domainApplication := range domainApplications {
+		protoApplications = append(protoApplications, &pb.RuleApplication{
+			RuleSet:           domainApplication.RuleSet,
+			Rule:              domainApplication.Rule,
+			Section:           domainApplication.Section,
+			Ingredient:        domainApplication.Ingredient,
+			Replacement:       domainApplication.Replacement,
+			ReplacedAmount:    domainApplication.ReplacedAmount,
+			ReplacementAmount: domainApplication.ReplacementAmount,
+		})
+	}
+	return protoApplications
+}
+
+func toProtoToppingGroups(domainGroups []domain.ToppingGroup) []*pb.ToppingGroup {
+	var protoGroups []*pb.ToppingGroup
+	for _, domainGroup := range domainGroups {
+		protoGroups = append(protoGroups, &pb.ToppingGroup{
+			Name:    domainGroup.Name,
+			Pans:    domainGroup.Pans,
+			Area:    domainGroup.Area,
+			Topping: toProtoTopping(domainGroup.Topping),
+		})
+	}
+	return protoGroups
+}
+
+func toProtoShoppingItems(domainShoppingItems []domain.ShoppingItem) []*pb.ShoppingItem {
+	protoShoppingItems := make([]*pb.ShoppingItem, 0, len(domainShoppingItems))
+	for _, domainShoppingItem := range domainShoppingItems {
+		protoShoppingItems = append(protoShoppingItems, &pb.ShoppingItem{
+			Name:           domainShoppingItem.Name,
+			RequiredAmount: domainShoppingItem.RequiredAmount,
+			StockAmount:    domainShoppingItem.StockAmount,
+			NeededAmount:   domainShoppingItem.NeededAmount,
+			PackageSize:    domainShoppingItem.PackageSize,
+			Packages:       int32(domainShoppingItem.Packages),
+			PurchaseAmount: domainShoppingItem.PurchaseAmount,
+		})
+	}
+	return protoShoppingItems
+}
+
 func toPointer(value *int32) *int {
 	if value == nil {
 		return nil
@@ -204,3 +998,30 @@ func toPointer(value *int32) *int {
 	val := int(*value)
 	return &val
 }
+
+func toMeasure(decimal *float64, value *int32) *float64 {
+	if decimal != nil {
+		return decimal
+	}
+	if value == nil {
+		return nil
+	}
+	val := float64(*value)
+	return &val
+}
+
+func toProtoMeasure(value *float64) *int32 {
+	if value == nil {
+		return nil
+	}
+	val := int32(math.Round(*value))
+	return &val
+}
+
+func toProtoPointer(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	val := int32(*value)
+	return &val
+}
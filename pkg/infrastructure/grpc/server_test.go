@@ -25,6 +25,83 @@ func (m *MockIngredientsBalancerService) Balance(ctx context.Context, recipe dom
 	return args.Get(0).(*domain.RecipeAggregate), args.Error(1)
 }
 
+func (m *MockIngredientsBalancerService) BalanceDoughBalls(ctx context.Context, recipe domain.Recipe, groups []domain.DoughBallGroup) (*domain.RecipeAggregate, error) {
+	args := m.Called(ctx, recipe, groups)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RecipeAggregate), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) SubstituteYeast(ctx context.Context, recipe domain.Recipe, substitution domain.YeastSubstitution) (domain.Recipe, []string, error) {
+	args := m.Called(ctx, recipe, substitution)
+	if args.Get(1) == nil {
+		return args.Get(0).(domain.Recipe), nil, args.Error(2)
+	}
+	return args.Get(0).(domain.Recipe), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *MockIngredientsBalancerService) ApplySubstitutionRules(ctx context.Context, recipe domain.Recipe, tenant string, ruleSets []string) (domain.Recipe, []domain.RuleApplication, error) {
+	args := m.Called(ctx, recipe, tenant, ruleSets)
+	if args.Get(1) == nil {
+		return args.Get(0).(domain.Recipe), nil, args.Error(2)
+	}
+	return args.Get(0).(domain.Recipe), args.Get(1).([]domain.RuleApplication), args.Error(2)
+}
+
+func (m *MockIngredientsBalancerService) PlanMixingBatches(ctx context.Context, recipeAggregate domain.RecipeAggregate, mixer domain.MixerProfile) (*domain.MixingPlan, error) {
+	args := m.Called(ctx, recipeAggregate, mixer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MixingPlan), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) CalculateWaterTemperature(ctx context.Context, recipeAggregate domain.RecipeAggregate, readings domain.DoughTemperatureReadings) (*domain.WaterTemperature, error) {
+	args := m.Called(ctx, recipeAggregate, readings)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaterTemperature), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) ReverseBalance(ctx context.Context, recipe domain.Recipe, candidates []domain.PanCandidate, limitingIngredient domain.Ingredient) (*domain.ReverseBalanceResult, error) {
+	args := m.Called(ctx, recipe, candidates, limitingIngredient)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ReverseBalanceResult), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) OptimizePans(ctx context.Context, recipe domain.Recipe, catalog []domain.PanCandidate, target domain.ServingsTarget) (*domain.PanOptimizationResult, error) {
+	args := m.Called(ctx, recipe, catalog, target)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PanOptimizationResult), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) GenerateShoppingList(ctx context.Context, recipeAggregates []domain.RecipeAggregate, packageSizes []domain.PackageSize, stock []domain.Ingredient) (*domain.ShoppingList, error) {
+	args := m.Called(ctx, recipeAggregates, packageSizes, stock)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShoppingList), args.Error(1)
+}
+
+func (m *MockIngredientsBalancerService) ValidateRecipe(ctx context.Context, recipe domain.Recipe) domain.RecipeValidation {
+	args := m.Called(ctx, recipe)
+	return args.Get(0).(domain.RecipeValidation)
+}
+
+func (m *MockIngredientsBalancerService) CompareScenarios(ctx context.Context, recipe domain.Recipe, scenarios []domain.PanScenario, servings int, prices []domain.IngredientPrice) ([]domain.ScenarioComparison, error) {
+	args := m.Called(ctx, recipe, scenarios, servings, prices)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ScenarioComparison), args.Error(1)
+}
+
 func TestNewServer(t *testing.T) {
 	mockService := &MockIngredientsBalancerService{}
 	server := NewServer(mockService)
@@ -124,7 +201,7 @@ func TestServer_Balance_Success(t *testing.T) {
 				Name:  "Teglia rotonda",
 				Area:  300,
 				Measures: domain.Measures{
-					Diameter: intPtr(30),
+					Diameter: float64Ptr(30),
 				},
 			},
 		},
@@ -156,7 +233,7 @@ func TestServer_Balance_Success(t *testing.T) {
 		},
 	}
 
-	mockService.On("Balance", mock.AnythingOfType("*context.emptyCtx"), expectedDomainRecipe, expectedDomainPans).Return(mockResult, nil)
+	mockService.On("Balance", mock.Anything, expectedDomainRecipe, expectedDomainPans).Return(mockResult, nil)
 
 	// Execute
 	response, err := server.Balance(context.Background(), protoRequest)
@@ -198,7 +275,7 @@ func TestServer_Balance_ServiceError(t *testing.T) {
 	}
 
 	expectedError := errors.New("servizio non disponibile")
-	mockService.On("Balance", mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("domain.Recipe"), mock.AnythingOfType("domain.Pans")).Return(nil, expectedError)
+	mockService.On("Balance", mock.Anything, mock.AnythingOfType("domain.Recipe"), mock.AnythingOfType("domain.Pans")).Return(nil, expectedError)
 
 	// Execute
 	response, err := server.Balance(context.Background(), protoRequest)
@@ -211,188 +288,1232 @@ func TestServer_Balance_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
-func TestToDomainRecipe(t *testing.T) {
-	recipeUUID := uuid.New()
-	protoRecipe := &pb.Recipe{
-		Id:          1,
-		Uuid:        recipeUUID.String(),
-		Name:        "Test Recipe",
-		Description: "Test Description",
-		Author:      "Test Author",
-		Dough: &pb.Dough{
-			Name:             "Test Dough",
-			PercentVariation: 5.0,
-			Ingredients: []*pb.Ingredient{
-				{Name: "Ingredient1", Amount: 100},
-			},
-		},
-		Topping: &pb.Topping{
-			Name:          "Test Topping",
-			ReferenceArea: 200,
-			Ingredients: []*pb.Ingredient{
-				{Name: "Ingredient2", Amount: 50},
-			},
+func TestServer_Balance_WithMixer(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:   &pb.Pans{TotalArea: 1000},
+		Mixer: &pb.MixerProfile{
+			MaxDoughWeight: 25000,
+			MinDoughWeight: 2000,
+			BowlCount:      2,
 		},
-		Steps: &pb.Steps{
-			RecipeId: 1,
-			Steps: []*pb.Step{
-				{Id: 1, StepNumber: 1, Description: "Step 1"},
-			},
+	}
+
+	balanced := &domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}}
+	mixingBatches := []domain.MixingBatch{
+		{
+			Number:      1,
+			Bowl:        1,
+			DoughWeight: 500,
+			Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 300}, {Name: "Acqua", Amount: 200}},
+			Pans:        []domain.PanPortion{{Name: "Teglia 1", DoughWeight: 500}},
 		},
 	}
 
-	result := toDomainRecipe(protoRecipe)
+	mockService.On("Balance", mock.Anything, mock.Anything, mock.Anything).Return(balanced, nil)
+	mockService.On("PlanMixingBatches", mock.Anything, *balanced, domain.MixerProfile{
+		MaxDoughWeight: 25000,
+		MinDoughWeight: 2000,
+		BowlCount:      2,
+	}).Return(&domain.MixingPlan{Batches: mixingBatches}, nil)
 
-	assert.Equal(t, 1, result.Id)
-	assert.Equal(t, recipeUUID, result.Uuid)
-	assert.Equal(t, "Test Recipe", result.Name)
-	assert.Equal(t, "Test Description", result.Description)
-	assert.Equal(t, "Test Author", result.Author)
-	assert.Equal(t, "Test Dough", result.Dough.Name)
-	assert.Equal(t, 5.0, result.Dough.PercentVariation)
-	assert.Len(t, result.Dough.Ingredients, 1)
-	assert.Equal(t, "Test Topping", result.Topping.Name)
-	assert.Len(t, result.Steps.Steps, 1)
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.RecipeAggregate.MixingBatches, 1)
+	batch := response.RecipeAggregate.MixingBatches[0]
+	assert.Equal(t, int32(1), batch.Bowl)
+	assert.Equal(t, 500.0, batch.DoughWeight)
+	assert.Len(t, batch.Ingredients, 2)
+	assert.Equal(t, "Teglia 1", batch.Pans[0].Name)
+
+	mockService.AssertExpectations(t)
 }
 
-func TestToDomainPans(t *testing.T) {
-	protoPans := &pb.Pans{
-		TotalArea: 500,
-		Pans: []*pb.Pan{
-			{
-				Shape: "rectangular",
-				Name:  "Teglia rettangolare",
-				Area:  250,
-				Measures: &pb.Measures{
-					Width:  int32Ptr(20),
-					Length: int32Ptr(30),
-				},
-			},
-			{
-				Shape: "circular",
-				Name:  "Teglia rotonda",
-				Area:  250,
-				Measures: &pb.Measures{
-					Diameter: int32Ptr(25),
-				},
-			},
+func TestServer_Balance_WithProcessLoss(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{
+			Name:  "Pizza in teglia",
+			Dough: &pb.Dough{ProcessLoss: &pb.ProcessLoss{MixerPercentage: 2, MinimumLoss: 30, PerBatchLoss: 10}},
 		},
+		Pans:  &pb.Pans{TotalArea: 1000},
+		Mixer: &pb.MixerProfile{MaxDoughWeight: 300},
 	}
 
-	result := toDomainPans(protoPans)
+	balancedDough := domain.Dough{
+		Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 300}, {Name: "Acqua", Amount: 200}},
+		ProcessLoss: &domain.ProcessLoss{MixerPercentage: 2, MinimumLoss: 30, PerBatchLoss: 10},
+	}
+	balanced := &domain.RecipeAggregate{
+		Recipe:            domain.Recipe{Name: "Pizza in teglia", Dough: balancedDough},
+		DoughToMix:        &domain.Dough{Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 324}, {Name: "Acqua", Amount: 216}}},
+		ProcessLossWeight: 40,
+	}
+	doughToMix := &domain.Dough{Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 330}, {Name: "Acqua", Amount: 220}}}
 
-	assert.Equal(t, 500.0, result.TotalArea)
-	assert.Len(t, result.Pans, 2)
+	mockService.On("Balance", mock.Anything, mock.MatchedBy(func(recipe domain.Recipe) bool {
+		return recipe.Dough.ProcessLoss != nil && recipe.Dough.ProcessLoss.MinimumLoss == 30
+	}), mock.Anything).Return(balanced, nil)
+	mockService.On("PlanMixingBatches", mock.Anything, *balanced, mock.Anything).Return(&domain.MixingPlan{
+		Batches:           []domain.MixingBatch{{Number: 1}, {Number: 2}},
+		DoughToMix:        doughToMix,
+		ProcessLossWeight: 50,
+	}, nil)
 
-	// Test prima teglia (rettangolare)
-	assert.Equal(t, "rectangular", result.Pans[0].Shape)
-	assert.Equal(t, "Teglia rettangolare", result.Pans[0].Name)
-	assert.Equal(t, 250.0, result.Pans[0].Area)
-	assert.Equal(t, 20, *result.Pans[0].Measures.Width)
-	assert.Equal(t, 30, *result.Pans[0].Measures.Length)
-	assert.Nil(t, result.Pans[0].Measures.Diameter)
+	response, err := server.Balance(context.Background(), protoRequest)
 
-	// Test seconda teglia (rotonda)
-	assert.Equal(t, "circular", result.Pans[1].Shape)
-	assert.Equal(t, "Teglia rotonda", result.Pans[1].Name)
-	assert.Equal(t, 25, *result.Pans[1].Measures.Diameter)
-	assert.Nil(t, result.Pans[1].Measures.Width)
-	assert.Nil(t, result.Pans[1].Measures.Length)
+	assert.NoError(t, err)
+	assert.Equal(t, 500.0, response.RecipeAggregate.Recipe.Dough.Ingredients[0].Amount+response.RecipeAggregate.Recipe.Dough.Ingredients[1].Amount)
+	assert.Equal(t, 330.0, response.RecipeAggregate.DoughToMix.Ingredients[0].Amount)
+	assert.Equal(t, 50.0, response.RecipeAggregate.ProcessLossWeight)
+	assert.Equal(t, 10.0, response.RecipeAggregate.Recipe.Dough.ProcessLoss.PerBatchLoss)
+
+	mockService.AssertExpectations(t)
 }
 
-func TestToProtoRecipeAggregate(t *testing.T) {
-	recipeUUID := uuid.New()
-	domainAggregate := &domain.RecipeAggregate{
-		Recipe: domain.Recipe{
-			Id:          1,
-			Uuid:        recipeUUID,
-			Name:        "Test Recipe",
-			Description: "Test Description",
-			Author:      "Test Author",
-			Dough: domain.Dough{
-				Name:        "Test Dough",
-				Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 1000}},
-			},
-			Topping: domain.Topping{
-				Name:        "Test Topping",
-				Ingredients: []domain.Ingredient{{Name: "Pomodoro", Amount: 200}},
-			},
-			Steps: domain.Steps{
-				RecipeId: 1,
-				Steps:    []domain.Step{{Id: 1, StepNumber: 1, Description: "Test Step"}},
-			},
-		},
-		SplitIngredients: domain.SplitIngredients{
-			SplitDough: []domain.Dough{
-				{Name: "Split Dough 1", Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 500}}},
-			},
-			SplitTopping: []domain.Topping{
-				{Name: "Split Topping 1", Ingredients: []domain.Ingredient{{Name: "Pomodoro", Amount: 100}}},
-			},
-		},
+func TestServer_Balance_MixerError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:   &pb.Pans{TotalArea: 1000},
+		Mixer:  &pb.MixerProfile{},
 	}
 
-	result := toProtoRecipeAggregate(domainAggregate)
+	expectedError := errors.New("impastatrice non valida")
+	mockService.On("Balance", mock.Anything, mock.Anything, mock.Anything).Return(&domain.RecipeAggregate{}, nil)
+	mockService.On("PlanMixingBatches", mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
 
-	assert.NotNil(t, result)
-	assert.NotNil(t, result.Recipe)
-	assert.Equal(t, int32(1), result.Recipe.Id)
-	assert.Equal(t, recipeUUID.String(), result.Recipe.Uuid)
-	assert.Equal(t, "Test Recipe", result.Recipe.Name)
-	assert.NotNil(t, result.SplitIngredients)
-	assert.Len(t, result.SplitIngredients.SplitDough, 1)
-	assert.Len(t, result.SplitIngredients.SplitTopping, 1)
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
 }
 
-func TestToPointer(t *testing.T) {
-	// Test con valore non nil
-	value := int32(42)
-	result := toPointer(&value)
-	assert.NotNil(t, result)
-	assert.Equal(t, 42, *result)
+func TestServer_Balance_WithTemperatures(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
 
-	// Test con valore nil
-	result = toPointer(nil)
-	assert.Nil(t, result)
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:   &pb.Pans{TotalArea: 1000},
+		Temperatures: &pb.DoughTemperatureReadings{
+			DesiredDoughTemperature: 24,
+			FlourTemperature:        26,
+			RoomTemperature:         28,
+			FrictionFactor:          14,
+		},
+	}
+
+	balanced := &domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}}
+	waterTemperature := &domain.WaterTemperature{Temperature: 4, IceNeeded: true, IceAmount: 75.3, WaterAmount: 574.7}
+
+	mockService.On("Balance", mock.Anything, mock.Anything, mock.Anything).Return(balanced, nil)
+	mockService.On("CalculateWaterTemperature", mock.Anything, *balanced, domain.DoughTemperatureReadings{
+		DesiredDoughTemperature: 24,
+		FlourTemperature:        26,
+		RoomTemperature:         28,
+		FrictionFactor:          14,
+	}).Return(waterTemperature, nil)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.WaterTemperature{
+		Temperature: 4,
+		IceNeeded:   true,
+		IceAmount:   75.3,
+		WaterAmount: 574.7,
+	}, response.RecipeAggregate.Recipe.Dough.WaterTemperature)
+
+	mockService.AssertExpectations(t)
 }
 
-func TestToDomainIngredients(t *testing.T) {
-	protoIngredients := []*pb.Ingredient{
-		{Name: "Farina", Amount: 1000},
-		{Name: "Acqua", Amount: 600},
-		{Name: "Sale", Amount: 20},
+func TestServer_Balance_TemperaturesAfterMixing(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:       &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:         &pb.Pans{TotalArea: 1000},
+		Mixer:        &pb.MixerProfile{MaxDoughWeight: 300},
+		Temperatures: &pb.DoughTemperatureReadings{DesiredDoughTemperature: 24},
 	}
 
-	result := toDomainIngredients(protoIngredients)
+	balanced := &domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}}
+	doughToMix := &domain.Dough{Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 330}, {Name: "Acqua", Amount: 220}}}
 
-	assert.Len(t, result, 3)
-	assert.Equal(t, "Farina", result[0].Name)
-	assert.Equal(t, 1000.0, result[0].Amount)
-	assert.Equal(t, "Acqua", result[1].Name)
-	assert.Equal(t, 600.0, result[1].Amount)
-	assert.Equal(t, "Sale", result[2].Name)
-	assert.Equal(t, 20.0, result[2].Amount)
+	mockService.On("Balance", mock.Anything, mock.Anything, mock.Anything).Return(balanced, nil)
+	mockService.On("PlanMixingBatches", mock.Anything, *balanced, mock.Anything).Return(&domain.MixingPlan{
+		Batches:    []domain.MixingBatch{{Number: 1}, {Number: 2}},
+		DoughToMix: doughToMix,
+	}, nil)
+	mockService.On("CalculateWaterTemperature", mock.Anything, mock.MatchedBy(func(recipeAggregate domain.RecipeAggregate) bool {
+		return recipeAggregate.DoughToMix == doughToMix
+	}), mock.Anything).Return(&domain.WaterTemperature{Temperature: 18, WaterAmount: 220}, nil)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 220.0, response.RecipeAggregate.Recipe.Dough.WaterTemperature.WaterAmount)
+
+	mockService.AssertExpectations(t)
 }
 
-func TestToProtoIngredients(t *testing.T) {
-	domainIngredients := []domain.Ingredient{
-		{Name: "Farina", Amount: 1000},
-		{Name: "Acqua", Amount: 600},
+func TestServer_Balance_TemperaturesError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:       &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:         &pb.Pans{TotalArea: 1000},
+		Temperatures: &pb.DoughTemperatureReadings{},
 	}
 
-	result := toProtoIngredients(domainIngredients)
+	expectedError := errors.New("temperatura impasto non valida")
+	mockService.On("Balance", mock.Anything, mock.Anything, mock.Anything).Return(&domain.RecipeAggregate{}, nil)
+	mockService.On("CalculateWaterTemperature", mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
 
-	assert.Len(t, result, 2)
-	assert.Equal(t, "Farina", result[0].Name)
-	assert.Equal(t, 1000.0, result[0].Amount)
-	assert.Equal(t, "Acqua", result[1].Name)
-	assert.Equal(t, 600.0, result[1].Amount)
-}
+	response, err := server.Balance(context.Background(), protoRequest)
 
-func int32Ptr(v int32) *int32 {
-	return &v
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
 }
 
-func intPtr(v int) *int {
+func TestServer_Balance_WithYeastSubstitution(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:   &pb.Pans{TotalArea: 1000},
+		YeastSubstitution: &pb.YeastSubstitution{
+			Target:  "instant",
+			Factors: map[string]float64{"instant": 0.3},
+		},
+	}
+
+	substituted := domain.Recipe{
+		Name: "Pizza in teglia",
+		Dough: domain.Dough{
+			Ingredients: []domain.Ingredient{{Name: "instant yeast", Amount: 0.1, Type: "yeast"}},
+		},
+	}
+	notes := []string{"fresh yeast replaced with instant yeast at 0.3 g per g of fresh yeast"}
+
+	mockService.On("SubstituteYeast", mock.Anything, mock.Anything, domain.YeastSubstitution{
+		Target:  "instant",
+		Factors: map[string]float64{"instant": 0.3},
+	}).Return(substituted, notes, nil)
+	mockService.On("Balance", mock.Anything, substituted, mock.Anything).Return(&domain.RecipeAggregate{Recipe: substituted}, nil)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, notes, response.RecipeAggregate.Notes)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_Balance_YeastSubstitutionError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:            &pb.Recipe{Name: "Pizza in teglia"},
+		Pans:              &pb.Pans{TotalArea: 1000},
+		YeastSubstitution: &pb.YeastSubstitution{Target: "birra"},
+	}
+
+	expectedError := errors.New("lievito sconosciuto")
+	mockService.On("SubstituteYeast", mock.Anything, mock.Anything, mock.Anything).Return(domain.Recipe{}, nil, expectedError)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+	mockService.AssertNotCalled(t, "Balance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServer_Balance_WithSubstitutionRules(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:   &pb.Recipe{Name: "Margherita"},
+		Pans:     &pb.Pans{TotalArea: 1000},
+		Tenant:   "pizzeria-roma",
+		RuleSets: []string{"vegan"},
+	}
+
+	substituted := domain.Recipe{
+		Name: "Margherita",
+		Topping: domain.Topping{
+			Ingredients: []domain.Ingredient{{Name: "mozzarella vegana", Amount: 180}},
+		},
+	}
+	appliedRules := []domain.RuleApplication{
+		{RuleSet: "vegan", Rule: "mozzarella vegana", Section: "topping", Ingredient: "fior di latte", Replacement: "mozzarella vegana", ReplacedAmount: 200, ReplacementAmount: 180},
+	}
+
+	mockService.On("ApplySubstitutionRules", mock.Anything, mock.Anything, "pizzeria-roma", []string{"vegan"}).Return(substituted, appliedRules, nil)
+	mockService.On("Balance", mock.Anything, substituted, mock.Anything).Return(&domain.RecipeAggregate{Recipe: substituted}, nil)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.RuleApplication{
+		{RuleSet: "vegan", Rule: "mozzarella vegana", Section: "topping", Ingredient: "fior di latte", Replacement: "mozzarella vegana", ReplacedAmount: 200, ReplacementAmount: 180},
+	}, response.RecipeAggregate.AppliedRules)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_Balance_SubstitutionRulesError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:   &pb.Recipe{Name: "Margherita"},
+		RuleSets: []string{"senza glutine"},
+	}
+
+	expectedError := errors.New("regole sconosciute")
+	mockService.On("ApplySubstitutionRules", mock.Anything, mock.Anything, "", []string{"senza glutine"}).Return(domain.Recipe{}, nil, expectedError)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestServer_Balance_WithDoughBalls(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza napoletana"},
+		DoughBalls: []*pb.DoughBallGroup{
+			{Count: 6, BallWeight: 250},
+			{Name: "Pizze da 32", Count: 2, Diameter: 32, ThicknessFactor: 0.35},
+		},
+	}
+
+	expectedGroups := []domain.DoughBallGroup{
+		{Count: 6, BallWeight: 250},
+		{Name: "Pizze da 32", Count: 2, Diameter: 32, ThicknessFactor: 0.35},
+	}
+	mockResult := &domain.RecipeAggregate{
+		Recipe: domain.Recipe{Name: "Pizza napoletana"},
+		DoughBalls: []domain.DoughBallPortion{
+			{Name: "6 x 250g", Count: 6, BallWeight: 250, Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 150}}},
+		},
+		SurplusDough: domain.Dough{Name: "surplus", Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 20}}},
+	}
+
+	mockService.On("BalanceDoughBalls", mock.Anything, mock.AnythingOfType("domain.Recipe"), expectedGroups).Return(mockResult, nil)
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.RecipeAggregate.DoughBalls, 1)
+	assert.Equal(t, int32(6), response.RecipeAggregate.DoughBalls[0].Count)
+	assert.Equal(t, 150.0, response.RecipeAggregate.DoughBalls[0].Ingredients[0].Amount)
+	assert.Equal(t, 20.0, response.RecipeAggregate.SurplusDough.Ingredients[0].Amount)
+
+	mockService.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "Balance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServer_Balance_PansAndDoughBalls(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.BalanceRequest{
+		Recipe:     &pb.Recipe{Name: "Pizza napoletana"},
+		Pans:       &pb.Pans{TotalArea: 700, Pans: []*pb.Pan{{Name: "Teglia", Area: 700}}},
+		DoughBalls: []*pb.DoughBallGroup{{Count: 6, BallWeight: 250}},
+	}
+
+	response, err := server.Balance(context.Background(), protoRequest)
+
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_ReverseBalance_Success(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.ReverseBalanceRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Candidates: []*pb.PanCandidate{
+			{Pan: &pb.Pan{Shape: "rectangular", Name: "Teglia grande", Area: 1200}, MaxQuantity: int32Ptr(2)},
+			{Pan: &pb.Pan{Shape: "circular", Name: "Teglia tonda", Area: 700, Measures: &pb.Measures{Diameter: int32Ptr(30)}}},
+		},
+		LimitingIngredient: &pb.Ingredient{Name: "Farina", Amount: 10000},
+	}
+
+	expectedCandidates := []domain.PanCandidate{
+		{Pan: domain.Pan{Shape: "rectangular", Name: "Teglia grande", Area: 1200}, MaxQuantity: intPtr(2)},
+		{Pan: domain.Pan{Shape: "circular", Name: "Teglia tonda", Area: 700, Measures: domain.Measures{Diameter: float64Ptr(30)}}},
+	}
+	mockResult := &domain.ReverseBalanceResult{
+		Selections: []domain.PanSelection{
+			{Pan: expectedCandidates[1].Pan, Quantity: 3},
+		},
+		RecipeAggregate: domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}},
+		UsedAmount:      9800,
+		LeftoverAmount:  200,
+	}
+
+	mockService.On("ReverseBalance", mock.Anything, mock.AnythingOfType("domain.Recipe"), expectedCandidates, domain.Ingredient{Name: "Farina", Amount: 10000}).Return(mockResult, nil)
+
+	response, err := server.ReverseBalance(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Selections, 1)
+	assert.Equal(t, int32(3), response.Selections[0].Quantity)
+	assert.Equal(t, "Teglia tonda", response.Selections[0].Pan.Name)
+	assert.Equal(t, int32(30), *response.Selections[0].Pan.Measures.Diameter)
+	assert.Equal(t, "Pizza in teglia", response.RecipeAggregate.Recipe.Name)
+	assert.Equal(t, 9800.0, response.UsedAmount)
+	assert.Equal(t, 200.0, response.LeftoverAmount)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_ReverseBalance_ServiceError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	expectedError := errors.New("farina insufficiente")
+	mockService.On("ReverseBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	response, err := server.ReverseBalance(context.Background(), &pb.ReverseBalanceRequest{})
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestServer_OptimizePans_Success(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.OptimizePansRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Catalog: []*pb.PanCandidate{
+			{Pan: &pb.Pan{Shape: "rectangular", Name: "Teglia", Area: 1200}},
+		},
+		Target: &pb.ServingsTarget{
+			Servings:       120,
+			SlicesPerShape: map[string]int32{"rectangular": 12},
+		},
+	}
+
+	expectedTarget := domain.ServingsTarget{
+		Servings:       120,
+		SlicesPerShape: map[string]int{"rectangular": 12},
+	}
+	mockResult := &domain.PanOptimizationResult{
+		Assortment: domain.PanAssortment{
+			Selections:  []domain.PanSelection{{Pan: domain.Pan{Shape: "rectangular", Name: "Teglia", Area: 1200}, Quantity: 10}},
+			Servings:    120,
+			Utilization: 100,
+		},
+		RecipeAggregate: domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}},
+	}
+
+	mockService.On("OptimizePans", mock.Anything, mock.AnythingOfType("domain.Recipe"), mock.AnythingOfType("[]domain.PanCandidate"), expectedTarget).Return(mockResult, nil)
+
+	response, err := server.OptimizePans(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Pizza in teglia", response.Balance.RecipeAggregate.Recipe.Name)
+	assert.Equal(t, int32(120), response.Assortment.Servings)
+	assert.Equal(t, 100.0, response.Assortment.Utilization)
+	assert.Len(t, response.Assortment.Selections, 1)
+	assert.Equal(t, int32(10), response.Assortment.Selections[0].Quantity)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_OptimizePans_ServiceError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	expectedError := errors.New("porzioni non raggiungibili")
+	mockService.On("OptimizePans", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	response, err := server.OptimizePans(context.Background(), &pb.OptimizePansRequest{})
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestServer_CompareScenarios_Success(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.CompareScenariosRequest{
+		Recipe: &pb.Recipe{Name: "Pizza in teglia"},
+		Scenarios: []*pb.PanScenario{
+			{Name: "Due teglie grandi", Pans: &pb.Pans{Pans: []*pb.Pan{{Name: "Teglia", Area: 1200, Slices: 12}}}},
+			{Name: "Cinque tonde", Pans: &pb.Pans{Pans: []*pb.Pan{{Name: "Tonda", Area: 500, Slices: 6}}}},
+		},
+		Servings: 20,
+		Prices:   []*pb.IngredientPrice{{Name: "Farina", Price: 1.2, Quantity: 1000}},
+	}
+
+	expectedScenarios := []domain.PanScenario{
+		{Name: "Due teglie grandi", Pans: domain.Pans{Pans: []domain.Pan{{Name: "Teglia", Area: 1200, Slices: 12}}}},
+		{Name: "Cinque tonde", Pans: domain.Pans{Pans: []domain.Pan{{Name: "Tonda", Area: 500, Slices: 6}}}},
+	}
+	expectedPrices := []domain.IngredientPrice{{Name: "Farina", Price: 1.2, Quantity: 1000}}
+	cost := 1.44
+	mockComparisons := []domain.ScenarioComparison{
+		{
+			Name:                "Due teglie grandi",
+			RecipeAggregate:     domain.RecipeAggregate{Recipe: domain.Recipe{Name: "Pizza in teglia"}},
+			TotalDough:          1200,
+			TotalTopping:        720,
+			Servings:            24,
+			Leftover:            200,
+			Utilization:         83.3,
+			Cost:                &cost,
+			UnpricedIngredients: []string{"acqua"},
+		},
+		{Name: "Cinque tonde", TotalDough: 1250, Servings: 30, Leftover: 416.7, Utilization: 66.7},
+	}
+
+	mockService.On("CompareScenarios", mock.Anything, mock.AnythingOfType("domain.Recipe"), expectedScenarios, 20, expectedPrices).Return(mockComparisons, nil)
+
+	response, err := server.CompareScenarios(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Scenarios, 2)
+	assert.Equal(t, "Due teglie grandi", response.Scenarios[0].Name)
+	assert.Equal(t, "Pizza in teglia", response.Scenarios[0].RecipeAggregate.Recipe.Name)
+	assert.Equal(t, 1200.0, response.Scenarios[0].TotalDough)
+	assert.Equal(t, int32(24), response.Scenarios[0].Servings)
+	assert.Equal(t, 83.3, response.Scenarios[0].Utilization)
+	assert.Equal(t, 1.44, response.Scenarios[0].GetCost())
+	assert.Equal(t, []string{"acqua"}, response.Scenarios[0].UnpricedIngredients)
+	assert.Nil(t, response.Scenarios[1].Cost)
+	assert.Equal(t, 416.7, response.Scenarios[1].Leftover)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_CompareScenarios_ServiceError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	expectedError := errors.New("nessuno scenario fornito")
+	mockService.On("CompareScenarios", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	response, err := server.CompareScenarios(context.Background(), &pb.CompareScenariosRequest{})
+
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestServer_GenerateShoppingList_Success(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.ShoppingListRequest{
+		RecipeAggregates: []*pb.RecipeAggregate{
+			{
+				Recipe: &pb.Recipe{
+					Name: "Pizza Margherita",
+					Dough: &pb.Dough{
+						Ingredients: []*pb.Ingredient{{Name: "Farina", Amount: 30000}},
+					},
+				},
+			},
+		},
+		PackageSizes: []*pb.PackageSize{{Name: "Farina", Size: 25000}},
+		Stock:        []*pb.Ingredient{{Name: "Farina", Amount: 6000}},
+	}
+
+	expectedPackageSizes := []domain.PackageSize{{Name: "Farina", Size: 25000}}
+	expectedStock := []domain.Ingredient{{Name: "Farina", Amount: 6000}}
+	mockResult := &domain.ShoppingList{
+		Items: []domain.ShoppingItem{
+			{
+				Name:           "farina",
+				RequiredAmount: 30000,
+				StockAmount:    6000,
+				NeededAmount:   24000,
+				PackageSize:    25000,
+				Packages:       1,
+				PurchaseAmount: 25000,
+			},
+		},
+	}
+
+	mockService.On("GenerateShoppingList", mock.Anything, mock.MatchedBy(func(recipeAggregates []domain.RecipeAggregate) bool {
+		return len(recipeAggregates) == 1 && recipeAggregates[0].Dough.Ingredients[0].Amount == 30000
+	}), expectedPackageSizes, expectedStock).Return(mockResult, nil)
+
+	response, err := server.GenerateShoppingList(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, int32(1), response.Items[0].Packages)
+	assert.Equal(t, 25000.0, response.Items[0].PurchaseAmount)
+	assert.Contains(t, response.Json, `"name":"farina"`)
+	assert.Contains(t, response.Csv, "farina,30000,6000,24000,25000,1,25000")
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_GenerateShoppingList_ServiceError(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	expectedError := errors.New("nessuna ricetta bilanciata")
+	mockService.On("GenerateShoppingList", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	response, err := server.GenerateShoppingList(context.Background(), &pb.ShoppingListRequest{})
+
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	assert.Equal(t, expectedError, err)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestServer_ValidateRecipe(t *testing.T) {
+	mockService := &MockIngredientsBalancerService{}
+	server := NewServer(mockService)
+
+	protoRequest := &pb.ValidateRecipeRequest{
+		Recipe: &pb.Recipe{
+			Name: "Focaccia",
+			Dough: &pb.Dough{
+				Ingredients: []*pb.Ingredient{{Name: "Farina", Amount: 100}},
+				Targets:     &pb.DoughTargets{Hydration: -70, Salt: 2.8, Yeast: 0.2},
+			},
+		},
+	}
+
+	mockService.On("ValidateRecipe", mock.Anything, mock.MatchedBy(func(recipe domain.Recipe) bool {
+		return recipe.Dough.Targets == domain.DoughTargets{Hydration: -70, Salt: 2.8, Yeast: 0.2}
+	})).Return(domain.RecipeValidation{Issues: []string{"hydration target cannot be negative"}})
+
+	response, err := server.ValidateRecipe(context.Background(), protoRequest)
+
+	assert.NoError(t, err)
+	assert.False(t, response.Valid)
+	assert.Equal(t, []string{"hydration target cannot be negative"}, response.Issues)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestToDomainRecipe(t *testing.T) {
+	recipeUUID := uuid.New()
+	protoRecipe := &pb.Recipe{
+		Id:          1,
+		Uuid:        recipeUUID.String(),
+		Name:        "Test Recipe",
+		Description: "Test Description",
+		Author:      "Test Author",
+		Dough: &pb.Dough{
+			Name:             "Test Dough",
+			PercentVariation: 5.0,
+			Ingredients: []*pb.Ingredient{
+				{Name: "Ingredient1", Amount: 100},
+			},
+		},
+		Topping: &pb.Topping{
+			Name:          "Test Topping",
+			ReferenceArea: 200,
+			Ingredients: []*pb.Ingredient{
+				{Name: "Ingredient2", Amount: 50},
+			},
+		},
+		Steps: &pb.Steps{
+			RecipeId: 1,
+			Steps: []*pb.Step{
+				{Id: 1, StepNumber: 1, Description: "Step 1"},
+			},
+		},
+	}
+
+	result := toDomainRecipe(protoRecipe)
+
+	assert.Equal(t, 1, result.Id)
+	assert.Equal(t, recipeUUID, result.Uuid)
+	assert.Equal(t, "Test Recipe", result.Name)
+	assert.Equal(t, "Test Description", result.Description)
+	assert.Equal(t, "Test Author", result.Author)
+	assert.Equal(t, "Test Dough", result.Dough.Name)
+	assert.Equal(t, 5.0, result.Dough.PercentVariation)
+	assert.Len(t, result.Dough.Ingredients, 1)
+	assert.Equal(t, "Test Topping", result.Topping.Name)
+	assert.Len(t, result.Steps.Steps, 1)
+}
+
+func TestToDomainRecipe_WithPreferment(t *testing.T) {
+	protoRecipe := &pb.Recipe{
+		Dough: &pb.Dough{
+			Name: "Impasto con biga",
+			Preferment: &pb.Preferment{
+				Type:            "biga",
+				Hydration:       45,
+				FlourPercentage: 50,
+				YeastPercentage: 1,
+			},
+		},
+	}
+
+	result := toDomainRecipe(protoRecipe)
+
+	assert.Equal(t, &domain.Preferment{
+		Type:            "biga",
+		Hydration:       45,
+		FlourPercentage: 50,
+		YeastPercentage: 1,
+	}, result.Dough.Preferment)
+	assert.Nil(t, toDomainRecipe(&pb.Recipe{Dough: &pb.Dough{}}).Dough.Preferment)
+}
+
+func TestToDomainRecipe_WithFermentation(t *testing.T) {
+	protoRecipe := &pb.Recipe{
+		Dough: &pb.Dough{
+			Fermentation: &pb.Fermentation{Hours: 24, Temperature: 18, YeastType: "instant"},
+		},
+	}
+
+	result := toDomainRecipe(protoRecipe)
+
+	assert.Equal(t, &domain.Fermentation{Hours: 24, Temperature: 18, YeastType: "instant"}, result.Dough.Fermentation)
+	assert.Nil(t, toDomainRecipe(&pb.Recipe{}).Dough.Fermentation)
+}
+
+func TestToDomainPans_WithSlicesAndScaling(t *testing.T) {
+	protoPans := &pb.Pans{
+		TotalArea: 600,
+		Pans:      []*pb.Pan{{Name: "Teglia", Area: 600, Slices: 8}},
+	}
+	protoIngredients := []*pb.Ingredient{{Name: "Uovo", Amount: 1, Scaling: "per_pan"}}
+
+	assert.Equal(t, 8, toDomainPans(protoPans).Pans[0].Slices)
+	assert.Equal(t, "per_pan", toDomainIngredients(protoIngredients)[0].Scaling)
+	assert.Equal(t, int32(8), toProtoPan(domain.Pan{Name: "Teglia", Slices: 8}).Slices)
+	assert.Equal(t, "per_pan", toProtoIngredients([]domain.Ingredient{{Name: "Uovo", Scaling: "per_pan"}})[0].Scaling)
+}
+
+func TestToDomainRecipe_WithToppingLayers(t *testing.T) {
+	protoRecipe := &pb.Recipe{
+		ToppingLayers: []*pb.Topping{
+			{Name: "Salsa", ReferenceArea: 1000, Ingredients: []*pb.Ingredient{{Name: "Pomodoro", Amount: 300}}},
+			{Name: "Guarnizione", Stage: "after_bake", Scaling: "per_slice"},
+		},
+	}
+
+	result := toDomainRecipe(protoRecipe)
+
+	assert.Len(t, result.ToppingLayers, 2)
+	assert.Equal(t, "Salsa", result.ToppingLayers[0].Name)
+	assert.Equal(t, 300.0, result.ToppingLayers[0].Ingredients[0].Amount)
+	assert.Equal(t, "after_bake", result.ToppingLayers[1].Stage)
+	assert.Equal(t, "per_slice", result.ToppingLayers[1].Scaling)
+	assert.Nil(t, toDomainRecipe(&pb.Recipe{}).ToppingLayers)
+}
+
+func TestToDomainPans_WithSections(t *testing.T) {
+	protoPans := &pb.Pans{
+		TotalArea: 600,
+		Pans: []*pb.Pan{{
+			Name: "Tonda",
+			Area: 600,
+			Sections: []*pb.PanSection{
+				{Name: "Metà margherita", Fraction: 0.5},
+				{Name: "Metà capricciosa", Fraction: 0.5, ToppingRecipe: "Capricciosa"},
+			},
+		}},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, []domain.PanSection{
+		{Name: "Metà margherita", Fraction: 0.5},
+		{Name: "Metà capricciosa", Fraction: 0.5, ToppingRecipe: "Capricciosa"},
+	}, result.Pans[0].Sections)
+	assert.Equal(t, protoPans.Pans[0].Sections, toProtoPan(result.Pans[0]).Sections)
+	assert.Nil(t, toDomainPan(&pb.Pan{}).Sections)
+}
+
+func TestToDomainPans_WithRimWidth(t *testing.T) {
+	protoPans := &pb.Pans{
+		TotalArea:        700,
+		Pans:             []*pb.Pan{{Name: "Tonda", Shape: "round", Area: 700, RimWidth: 2}},
+		DefaultRimWidths: map[string]float64{"square": 1.5, "Napoletana": 2.5},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, 2.0, result.Pans[0].RimWidth)
+	assert.Equal(t, map[string]float64{"square": 1.5, "Napoletana": 2.5}, result.DefaultRimWidths)
+	assert.Equal(t, 2.0, toProtoPan(result.Pans[0]).RimWidth)
+	assert.Equal(t, 530.9, toProtoRecipeAggregate(&domain.RecipeAggregate{ToppingArea: 530.9}).ToppingArea)
+}
+
+func TestToDomainPans_WithDeepPan(t *testing.T) {
+	height := 4.5
+	wallCoverage := 0.8
+	protoPans := &pb.Pans{
+		TotalArea: 875,
+		Pans: []*pb.Pan{{
+			Name:          "Detroit",
+			Shape:         "rectangular",
+			Area:          875,
+			Deep:          true,
+			TopCrustShare: 0.4,
+			Measures:      &pb.Measures{Height: &height, WallCoverage: &wallCoverage},
+		}},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.True(t, result.Pans[0].Deep)
+	assert.Equal(t, 0.4, result.Pans[0].TopCrustShare)
+	assert.Equal(t, 4.5, *result.Pans[0].Measures.Height)
+	assert.Equal(t, 0.8, *result.Pans[0].Measures.WallCoverage)
+
+	protoPan := toProtoPan(result.Pans[0])
+	assert.True(t, protoPan.Deep)
+	assert.Equal(t, 4.5, protoPan.Measures.GetHeight())
+	assert.Equal(t, 0.8, protoPan.Measures.GetWallCoverage())
+}
+
+func TestToProtoSplitIngredients_WithTopCrust(t *testing.T) {
+	domainSplitIngredients := domain.SplitIngredients{
+		SplitTopCrust: []domain.Dough{
+			{Name: "Chicago", Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 90}}},
+		},
+	}
+
+	result := toProtoSplitIngredients(domainSplitIngredients)
+
+	assert.Len(t, result.SplitTopCrust, 1)
+	assert.Equal(t, "Chicago", result.SplitTopCrust[0].Name)
+	assert.Equal(t, 90.0, result.SplitTopCrust[0].Ingredients[0].Amount)
+	assert.Equal(t, "Chicago", toDomainSplitIngredients(result).SplitTopCrust[0].Name)
+}
+
+func TestToDomainPans_WithGeometry(t *testing.T) {
+	sides, edge := int32(6), int32(10)
+	protoPans := &pb.Pans{
+		Pans: []*pb.Pan{
+			{Name: "Esagonale", Shape: "polygon", Measures: &pb.Measures{Sides: &sides, Edge: &edge}},
+			{Name: "Vassoio", Shape: "custom", Measures: &pb.Measures{Vertices: []*pb.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 0, Y: 40}}}},
+		},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, 6, *result.Pans[0].Measures.Sides)
+	assert.Equal(t, []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 0, Y: 40}}, result.Pans[1].Measures.Vertices)
+	assert.Equal(t, int32(6), toProtoPan(result.Pans[0]).Measures.GetSides())
+	assert.Len(t, toProtoPan(result.Pans[1]).Measures.Vertices, 3)
+}
+
+func TestToDomainPans_WithDecimalMeasures(t *testing.T) {
+	protoPans := &pb.Pans{
+		Pans: []*pb.Pan{
+			{Name: "Tonda", Shape: "round", Measures: &pb.Measures{Unit: "inch", Diameter: int32Ptr(14), DiameterValue: float64Ptr(14.5)}},
+			{Name: "Teglia", Shape: "rectangular", Measures: &pb.Measures{Width: int32Ptr(25), LengthValue: float64Ptr(35.5), CornerRadius: float64Ptr(2.5)}},
+			{Name: "Ovale", Shape: "oval", Measures: &pb.Measures{MajorAxis: float64Ptr(40.5), MinorAxis: float64Ptr(30.5)}},
+		},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, "inch", result.Pans[0].Measures.Unit)
+	assert.Equal(t, 14.5, *result.Pans[0].Measures.Diameter)
+	assert.Equal(t, 25.0, *result.Pans[1].Measures.Width)
+	assert.Equal(t, 35.5, *result.Pans[1].Measures.Length)
+	assert.Equal(t, 2.5, *result.Pans[1].Measures.CornerRadius)
+	assert.Equal(t, 40.5, *result.Pans[2].Measures.MajorAxis)
+	assert.Equal(t, 30.5, *result.Pans[2].Measures.MinorAxis)
+
+	protoMeasures := toProtoPan(result.Pans[1]).Measures
+	assert.Equal(t, int32(25), protoMeasures.GetWidth())
+	assert.Equal(t, 25.0, protoMeasures.GetWidthValue())
+	assert.Equal(t, int32(36), protoMeasures.GetLength())
+	assert.Equal(t, 35.5, protoMeasures.GetLengthValue())
+	assert.Nil(t, protoMeasures.Diameter)
+	assert.Nil(t, protoMeasures.DiameterValue)
+	assert.Equal(t, 2.5, protoMeasures.GetCornerRadius())
+	assert.Equal(t, 40.5, toProtoPan(result.Pans[2]).Measures.GetMajorAxis())
+}
+
+func TestToDomainPans_WithProfiles(t *testing.T) {
+	protoPans := &pb.Pans{
+		Pans: []*pb.Pan{{Name: "Teglia", Area: 1200, Material: "ferro blu", Style: "romana", Profile: "Teglia romana"}},
+		Profiles: []*pb.PanProfile{{
+			Name:          "Teglia romana",
+			Material:      "ferro blu",
+			Style:         "romana",
+			ReferenceArea: 100,
+			Ingredients:   []*pb.Ingredient{{Name: "Olio", Amount: 1.5}},
+		}},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, []domain.PanProfile{{
+		Name:          "Teglia romana",
+		Material:      "ferro blu",
+		Style:         "romana",
+		ReferenceArea: 100,
+		Ingredients:   []domain.Ingredient{{Name: "Olio", Amount: 1.5}},
+	}}, result.Profiles)
+	assert.Equal(t, "ferro blu", result.Pans[0].Material)
+	assert.Equal(t, "romana", toProtoPan(result.Pans[0]).Style)
+	assert.Equal(t, "Teglia romana", toProtoPan(result.Pans[0]).Profile)
+}
+
+func TestToDomainPans_WithThicknessMultiplier(t *testing.T) {
+	protoPans := &pb.Pans{Pans: []*pb.Pan{{Name: "Focaccia", Area: 600, ThicknessMultiplier: 1.8}}}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, 1.8, result.Pans[0].ThicknessMultiplier)
+	assert.Equal(t, 1.8, toProtoPan(result.Pans[0]).ThicknessMultiplier)
+}
+
+func TestToDomainPans_WithSlicingPattern(t *testing.T) {
+	protoPans := &pb.Pans{Pans: []*pb.Pan{{
+		Name:    "Teglia",
+		Area:    600,
+		Slicing: &pb.SlicingPattern{Pattern: "grid", Rows: 3, Columns: 4},
+	}}}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, &domain.SlicingPattern{Pattern: "grid", Rows: 3, Columns: 4}, result.Pans[0].Slicing)
+	assert.Equal(t, int32(4), toProtoPan(result.Pans[0]).Slicing.Columns)
+}
+
+func TestToProtoRecipeAggregate_WithSplitSlices(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		SplitIngredients: domain.SplitIngredients{
+			SplitSlices: []domain.PanSlices{{
+				Name:    "Teglia",
+				Count:   8,
+				Weight:  60,
+				Dough:   []domain.Ingredient{{Name: "Farina", Amount: 22.5}, {Name: "Acqua", Amount: 15}},
+				Topping: []domain.Ingredient{{Name: "Pomodoro", Amount: 22.5}},
+			}},
+		},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Equal(t, int32(8), result.SplitIngredients.SplitSlices[0].Count)
+	assert.Equal(t, 60.0, result.SplitIngredients.SplitSlices[0].Weight)
+
+	roundTrip := toDomainRecipeAggregate(result)
+	assert.Equal(t, domainAggregate.SplitIngredients.SplitSlices[0].Name, roundTrip.SplitIngredients.SplitSlices[0].Name)
+	assert.Equal(t, 22.5, roundTrip.SplitIngredients.SplitSlices[0].Topping[0].Amount)
+}
+
+func TestToProtoRecipeAggregate_WithPanPreparation(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		SplitIngredients: domain.SplitIngredients{
+			SplitPanPreparation: []domain.PanPreparation{
+				{Name: "Teglia", Profile: "Teglia romana", Ingredients: []domain.Ingredient{{Name: "Olio", Amount: 18}}},
+			},
+		},
+		PanPreparation: []domain.Ingredient{{Name: "Olio", Amount: 18}},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Len(t, result.PanPreparation, 1)
+	assert.Equal(t, 18.0, result.PanPreparation[0].Amount)
+	assert.Equal(t, "Teglia romana", result.SplitIngredients.SplitPanPreparation[0].Profile)
+
+	roundTrip := toDomainRecipeAggregate(result)
+	assert.Equal(t, domainAggregate.PanPreparation, roundTrip.PanPreparation)
+	assert.Equal(t, domainAggregate.SplitIngredients.SplitPanPreparation, roundTrip.SplitIngredients.SplitPanPreparation)
+}
+
+func TestToDomainRecipe_WithToppingRecipes(t *testing.T) {
+	protoRecipe := &pb.Recipe{
+		Topping: &pb.Topping{Name: "Margherita"},
+		ToppingRecipes: []*pb.Topping{
+			{Name: "Diavola", ReferenceArea: 1000, Ingredients: []*pb.Ingredient{{Name: "Salame", Amount: 100}}},
+		},
+	}
+
+	result := toDomainRecipe(protoRecipe)
+
+	assert.Len(t, result.ToppingRecipes, 1)
+	assert.Equal(t, "Diavola", result.ToppingRecipes[0].Name)
+	assert.Equal(t, 100.0, result.ToppingRecipes[0].Ingredients[0].Amount)
+	assert.Equal(t, "Diavola", toDomainPan(&pb.Pan{ToppingRecipe: "Diavola"}).ToppingRecipe)
+	assert.Equal(t, "Diavola", toDomainDoughBallGroups([]*pb.DoughBallGroup{{ToppingRecipe: "Diavola"}})[0].ToppingRecipe)
+}
+
+func TestToProtoRecipeAggregate_WithToppingGroups(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		ToppingGroups: []domain.ToppingGroup{
+			{
+				Name:    "Diavola",
+				Pans:    []string{"Teglia 1", "Teglia 2"},
+				Area:    1000,
+				Topping: domain.Topping{Name: "Diavola", Ingredients: []domain.Ingredient{{Name: "Salame", Amount: 100}}},
+			},
+		},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Len(t, result.ToppingGroups, 1)
+	assert.Equal(t, []string{"Teglia 1", "Teglia 2"}, result.ToppingGroups[0].Pans)
+	assert.Equal(t, 1000.0, result.ToppingGroups[0].Area)
+	assert.Equal(t, "Salame", result.ToppingGroups[0].Topping.Ingredients[0].Name)
+	assert.Nil(t, toProtoRecipeAggregate(&domain.RecipeAggregate{}).ToppingGroups)
+}
+
+func TestToProtoSplitIngredients_WithToppingLayers(t *testing.T) {
+	domainSplitIngredients := domain.SplitIngredients{
+		SplitToppingLayers: []domain.PanToppingLayers{
+			{
+				Name: "Teglia 1",
+				Layers: []domain.Topping{
+					{Name: "Salsa", Stage: "before_bake", Ingredients: []domain.Ingredient{{Name: "Pomodoro", Amount: 180}}},
+				},
+			},
+		},
+	}
+
+	result := toProtoSplitIngredients(domainSplitIngredients)
+
+	assert.Len(t, result.SplitToppingLayers, 1)
+	assert.Equal(t, "Teglia 1", result.SplitToppingLayers[0].Name)
+	assert.Equal(t, "before_bake", result.SplitToppingLayers[0].Layers[0].Stage)
+	assert.Equal(t, domainSplitIngredients.SplitToppingLayers, toDomainSplitIngredients(result).SplitToppingLayers)
+}
+
+func TestToDomainPans(t *testing.T) {
+	protoPans := &pb.Pans{
+		TotalArea: 500,
+		Pans: []*pb.Pan{
+			{
+				Shape: "rectangular",
+				Name:  "Teglia rettangolare",
+				Area:  250,
+				Measures: &pb.Measures{
+					Width:  int32Ptr(20),
+					Length: int32Ptr(30),
+				},
+			},
+			{
+				Shape: "circular",
+				Name:  "Teglia rotonda",
+				Area:  250,
+				Measures: &pb.Measures{
+					Diameter: int32Ptr(25),
+				},
+			},
+		},
+	}
+
+	result := toDomainPans(protoPans)
+
+	assert.Equal(t, 500.0, result.TotalArea)
+	assert.Len(t, result.Pans, 2)
+
+	// Test prima teglia (rettangolare)
+	assert.Equal(t, "rectangular", result.Pans[0].Shape)
+	assert.Equal(t, "Teglia rettangolare", result.Pans[0].Name)
+	assert.Equal(t, 250.0, result.Pans[0].Area)
+	assert.Equal(t, 20.0, *result.Pans[0].Measures.Width)
+	assert.Equal(t, 30.0, *result.Pans[0].Measures.Length)
+	assert.Nil(t, result.Pans[0].Measures.Diameter)
+
+	// Test seconda teglia (rotonda)
+	assert.Equal(t, "circular", result.Pans[1].Shape)
+	assert.Equal(t, "Teglia rotonda", result.Pans[1].Name)
+	assert.Equal(t, 25.0, *result.Pans[1].Measures.Diameter)
+	assert.Nil(t, result.Pans[1].Measures.Width)
+	assert.Nil(t, result.Pans[1].Measures.Length)
+}
+
+func TestToProtoRecipeAggregate(t *testing.T) {
+	recipeUUID := uuid.New()
+	domainAggregate := &domain.RecipeAggregate{
+		Recipe: domain.Recipe{
+			Id:          1,
+			Uuid:        recipeUUID,
+			Name:        "Test Recipe",
+			Description: "Test Description",
+			Author:      "Test Author",
+			Dough: domain.Dough{
+				Name:        "Test Dough",
+				Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 1000}},
+			},
+			Topping: domain.Topping{
+				Name:        "Test Topping",
+				Ingredients: []domain.Ingredient{{Name: "Pomodoro", Amount: 200}},
+			},
+			Steps: domain.Steps{
+				RecipeId: 1,
+				Steps:    []domain.Step{{Id: 1, StepNumber: 1, Description: "Test Step"}},
+			},
+		},
+		SplitIngredients: domain.SplitIngredients{
+			SplitDough: []domain.Dough{
+				{Name: "Split Dough 1", Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 500}}},
+			},
+			SplitTopping: []domain.Topping{
+				{Name: "Split Topping 1", Ingredients: []domain.Ingredient{{Name: "Pomodoro", Amount: 100}}},
+			},
+		},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.NotNil(t, result)
+	assert.NotNil(t, result.Recipe)
+	assert.Equal(t, int32(1), result.Recipe.Id)
+	assert.Equal(t, recipeUUID.String(), result.Recipe.Uuid)
+	assert.Equal(t, "Test Recipe", result.Recipe.Name)
+	assert.NotNil(t, result.SplitIngredients)
+	assert.Len(t, result.SplitIngredients.SplitDough, 1)
+	assert.Len(t, result.SplitIngredients.SplitTopping, 1)
+}
+
+func TestToProtoRecipeAggregate_WithPrefermentSplit(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		PrefermentSplit: &domain.PrefermentSplit{
+			Preferment: domain.Dough{Name: "poolish", Ingredients: []domain.Ingredient{{Name: "Farina", Amount: 300}}},
+			FinalDough: domain.Dough{Name: "final dough", Ingredients: []domain.Ingredient{{Name: "poolish", Amount: 600}}},
+		},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Equal(t, "poolish", result.PrefermentSplit.Preferment.Name)
+	assert.Equal(t, 300.0, result.PrefermentSplit.Preferment.Ingredients[0].Amount)
+	assert.Equal(t, 600.0, result.PrefermentSplit.FinalDough.Ingredients[0].Amount)
+	assert.Nil(t, toProtoRecipeAggregate(&domain.RecipeAggregate{}).PrefermentSplit)
+}
+
+func TestToProtoRecipeAggregate_WithFormula(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		FormulaPercentages: []domain.Ingredient{{Name: "Farina", Amount: 100}, {Name: "Acqua", Amount: 72}},
+		EffectiveHydration: 72,
+		Warnings:           []string{"effective hydration 72% differs from target hydration 70%"},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Len(t, result.FormulaPercentages, 2)
+	assert.Equal(t, 72.0, result.EffectiveHydration)
+	assert.Equal(t, domainAggregate.Warnings, result.Warnings)
+	assert.Nil(t, result.SolvedTargets)
+}
+
+func TestToProtoRecipeAggregate_WithSolvedTargets(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		SolvedTargets: &domain.SolvedTargets{TotalFlour: 1000, Water: 700, Salt: 28, Yeast: 2},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Equal(t, &pb.SolvedTargets{TotalFlour: 1000, Water: 700, Salt: 28, Yeast: 2}, result.SolvedTargets)
+}
+
+func TestToProtoRecipeAggregate_WithLeavening(t *testing.T) {
+	domainAggregate := &domain.RecipeAggregate{
+		Leavening: &domain.Leavening{YeastType: "fresh", YeastPercentage: 0.1, FreshYeast: 1, InstantYeast: 0.3, DryYeast: 0.4},
+	}
+
+	result := toProtoRecipeAggregate(domainAggregate)
+
+	assert.Equal(t, &pb.Leavening{YeastType: "fresh", YeastPercentage: 0.1, FreshYeast: 1, InstantYeast: 0.3, DryYeast: 0.4}, result.Leavening)
+	assert.Nil(t, toProtoRecipeAggregate(&domain.RecipeAggregate{}).Leavening)
+}
+
+func TestToPointer(t *testing.T) {
+	// Test con valore non nil
+	value := int32(42)
+	result := toPointer(&value)
+	assert.NotNil(t, result)
+	assert.Equal(t, 42, *result)
+
+	// Test con valore nil
+	result = toPointer(nil)
+	assert.Nil(t, result)
+}
+
+func TestToDomainIngredients(t *testing.T) {
+	protoIngredients := []*pb.Ingredient{
+		{Name: "Farina", Amount: 1000},
+		{Name: "Acqua", Amount: 600},
+		{Name: "Sale", Amount: 20},
+	}
+
+	result := toDomainIngredients(protoIngredients)
+
+	assert.Len(t, result, 3)
+	assert.Equal(t, "Farina", result[0].Name)
+	assert.Equal(t, 1000.0, result[0].Amount)
+	assert.Equal(t, "Acqua", result[1].Name)
+	assert.Equal(t, 600.0, result[1].Amount)
+	assert.Equal(t, "Sale", result[2].Name)
+	assert.Equal(t, 20.0, result[2].Amount)
+}
+
+func TestToDomainIngredients_WithType(t *testing.T) {
+	result := toDomainIngredients([]*pb.Ingredient{
+		{Name: "Lievito madre", Amount: 200, Type: "starter", Hydration: 50},
+	})
+
+	assert.Equal(t, []domain.Ingredient{
+		{Name: "Lievito madre", Amount: 200, Type: "starter", Hydration: 50},
+	}, result)
+}
+
+func TestToProtoIngredients(t *testing.T) {
+	domainIngredients := []domain.Ingredient{
+		{Name: "Farina", Amount: 1000},
+		{Name: "Acqua", Amount: 600},
+	}
+
+	result := toProtoIngredients(domainIngredients)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "Farina", result[0].Name)
+	assert.Equal(t, 1000.0, result[0].Amount)
+	assert.Equal(t, "Acqua", result[1].Name)
+	assert.Equal(t, 600.0, result[1].Amount)
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func float64Ptr(v float64) *float64 {
 	return &v
 }
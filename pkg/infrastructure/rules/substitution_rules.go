@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+type ingredientJSON struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+type substitutionRuleJSON struct {
+	Name        string           `json:"name"`
+	Ingredient  string           `json:"ingredient"`
+	Kind        string           `json:"kind"`
+	Replacement string           `json:"replacement"`
+	Factor      float64          `json:"factor"`
+	Fraction    float64          `json:"fraction"`
+	Additions   []ingredientJSON `json:"additions"`
+}
+
+type substitutionCatalogJSON struct {
+	RuleSets map[string][]substitutionRuleJSON `json:"rule_sets"`
+	Tenants  map[string][]string               `json:"tenants"`
+}
+
+func LoadSubstitutionCatalog(path string) (domain.SubstitutionCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.SubstitutionCatalog{}, err
+	}
+	return ParseSubstitutionCatalog(data)
+}
+
+func ParseSubstitutionCatalog(data []byte) (domain.SubstitutionCatalog, error) {
+	var catalogJSON substitutionCatalogJSON
+	if err := json.Unmarshal(data, &catalogJSON); err != nil {
+		return domain.SubstitutionCatalog{}, err
+	}
+
+	catalog := domain.SubstitutionCatalog{
+		RuleSets: make(map[string][]domain.SubstitutionRule, len(catalogJSON.RuleSets)),
+		Tenants:  catalogJSON.Tenants,
+	}
+	for ruleSet, rulesJSON := range catalogJSON.RuleSets {
+		for _, ruleJSON := range rulesJSON {
+			if err := validateRule(ruleJSON); err != nil {
+				return domain.SubstitutionCatalog{}, fmt.Errorf("rule set %s: %w", ruleSet, err)
+			}
+			catalog.RuleSets[ruleSet] = append(catalog.RuleSets[ruleSet], toDomainRule(ruleJSON))
+		}
+	}
+	for tenant, ruleSets := range catalogJSON.Tenants {
+		for _, ruleSet := range ruleSets {
+			if _, ok := catalog.RuleSets[ruleSet]; !ok {
+				return domain.SubstitutionCatalog{}, fmt.Errorf("tenant %s: unknown rule set %s", tenant, ruleSet)
+			}
+		}
+	}
+
+	return catalog, nil
+}
+
+func validateRule(rule substitutionRuleJSON) error {
+	switch {
+	case rule.Name == "":
+		return errors.New("rule without name")
+	case rule.Ingredient == "" && rule.Kind == "":
+		return errors.New("rule " + rule.Name + " matches no ingredient")
+	case rule.Replacement == "" && len(rule.Additions) == 0:
+		return errors.New("rule " + rule.Name + " has no replacement or additions")
+	case rule.Factor < 0:
+		return errors.New("rule " + rule.Name + " has a negative factor")
+	case rule.Fraction < 0 || rule.Fraction > 1:
+		return errors.New("rule " + rule.Name + " has a fraction outside 0-1")
+	}
+	return nil
+}
+
+func toDomainRule(rule substitutionRuleJSON) domain.SubstitutionRule {
+	additions := make([]domain.Ingredient, 0, len(rule.Additions))
+	for _, addition := range rule.Additions {
+		additions = append(additions, domain.Ingredient{Name: addition.Name, Amount: addition.Amount})
+	}
+	return domain.SubstitutionRule{
+		Name:        rule.Name,
+		Ingredient:  rule.Ingredient,
+		Kind:        rule.Kind,
+		Replacement: rule.Replacement,
+		Factor:      rule.Factor,
+		Fraction:    rule.Fraction,
+		Additions:   additions,
+	}
+}
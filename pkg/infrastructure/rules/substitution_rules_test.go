@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/ingredients-balancer/pkg/domain"
+)
+
+const catalogJSON = `{
+	"rule_sets": {
+		"vegan": [
+			{"name": "vegan mozzarella", "ingredient": "fior di latte", "replacement": "vegan mozzarella", "factor": 0.9}
+		],
+		"whole-wheat": [
+			{"name": "whole wheat 20%", "kind": "flour", "replacement": "whole wheat flour", "fraction": 0.2, "additions": [{"name": "water", "amount": 5}]}
+		]
+	},
+	"tenants": {
+		"pizzeria-roma": ["vegan", "whole-wheat"]
+	}
+}`
+
+func TestParseSubstitutionCatalog(t *testing.T) {
+	catalog, err := ParseSubstitutionCatalog([]byte(catalogJSON))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubstitutionRule{
+		{Name: "vegan mozzarella", Ingredient: "fior di latte", Replacement: "vegan mozzarella", Factor: 0.9, Additions: []domain.Ingredient{}},
+	}, catalog.RuleSets["vegan"])
+	assert.Equal(t, []domain.Ingredient{{Name: "water", Amount: 5}}, catalog.RuleSets["whole-wheat"][0].Additions)
+	assert.Equal(t, []string{"vegan", "whole-wheat"}, catalog.Tenants["pizzeria-roma"])
+}
+
+func TestParseSubstitutionCatalog_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "malformed json",
+			data: `{"rule_sets": [}`,
+			err:  "invalid character '}' looking for beginning of value",
+		},
+		{
+			name: "rule without match",
+			data: `{"rule_sets": {"vegan": [{"name": "mozzarella", "replacement": "tofu"}]}}`,
+			err:  "rule set vegan: rule mozzarella matches no ingredient",
+		},
+		{
+			name: "fraction out of range",
+			data: `{"rule_sets": {"whole-wheat": [{"name": "ww", "kind": "flour", "replacement": "whole wheat flour", "fraction": 1.5}]}}`,
+			err:  "rule set whole-wheat: rule ww has a fraction outside 0-1",
+		},
+		{
+			name: "unknown tenant rule set",
+			data: `{"rule_sets": {}, "tenants": {"pizzeria-roma": ["vegan"]}}`,
+			err:  "tenant pizzeria-roma: unknown rule set vegan",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSubstitutionCatalog([]byte(tt.data))
+
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestLoadSubstitutionCatalog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	assert.NoError(t, os.WriteFile(path, []byte(catalogJSON), 0o600))
+
+	catalog, err := LoadSubstitutionCatalog(path)
+
+	assert.NoError(t, err)
+	assert.Len(t, catalog.RuleSets, 2)
+
+	_, err = LoadSubstitutionCatalog(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
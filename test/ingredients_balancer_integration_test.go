@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	prometheusMetrics "github.com/cfioretti/ingredients-balancer/internal/infrastructure/metrics"
 	"github.com/cfioretti/ingredients-balancer/pkg/application"
 	grpcServer "github.com/cfioretti/ingredients-balancer/pkg/infrastructure/grpc"
 	pb "github.com/cfioretti/ingredients-balancer/pkg/infrastructure/grpc/proto/generated"
@@ -31,7 +32,7 @@ func TestIngredientsBalancerIntegration(t *testing.T) {
 			return
 		}
 
-		ingredientsBalancerService := application.NewIngredientsBalancerService()
+		ingredientsBalancerService := application.NewIngredientsBalancerService(prometheusMetrics.NewPrometheusMetrics())
 		server := grpcServer.NewServer(ingredientsBalancerService)
 		grpcNewServer := grpc.NewServer()
 		pb.RegisterIngredientsBalancerServer(grpcNewServer, server)